@@ -0,0 +1,66 @@
+package synchro
+
+import "fmt"
+
+// Bounds determines whether from and to of a Period are included in
+// the period. It is written in the mathematical interval notation, where
+// the first character is for from and the second is for to.
+type Bounds int
+
+const (
+	// BoundsClosed includes both from and to: "[]". This is the default.
+	BoundsClosed Bounds = iota
+	// BoundsClosedOpen includes from and excludes to: "[)".
+	BoundsClosedOpen
+	// BoundsOpenClosed excludes from and includes to: "(]".
+	BoundsOpenClosed
+	// BoundsOpen excludes both from and to: "()".
+	BoundsOpen
+)
+
+var boundsNames = [...]string{
+	BoundsClosed:     "[]",
+	BoundsClosedOpen: "[)",
+	BoundsOpenClosed: "(]",
+	BoundsOpen:       "()",
+}
+
+// String implements the fmt.Stringer interface.
+func (b Bounds) String() string {
+	if b < BoundsClosed || b > BoundsOpen {
+		return fmt.Sprintf("Bounds(%d)", int(b))
+	}
+	return boundsNames[b]
+}
+
+// ParseBounds parses the bounds written in the interval notation such as "[)".
+func ParseBounds(s string) (Bounds, error) {
+	for b, name := range boundsNames {
+		if s == name {
+			return Bounds(b), nil
+		}
+	}
+	return 0, fmt.Errorf("invalid bounds: %q", s)
+}
+
+// IncludesFrom reports whether from is included.
+func (b Bounds) IncludesFrom() bool {
+	return b == BoundsClosed || b == BoundsClosedOpen
+}
+
+// IncludesTo reports whether to is included.
+func (b Bounds) IncludesTo() bool {
+	return b == BoundsClosed || b == BoundsOpenClosed
+}
+
+func boundsOf(includesFrom, includesTo bool) Bounds {
+	switch {
+	case includesFrom && includesTo:
+		return BoundsClosed
+	case includesFrom:
+		return BoundsClosedOpen
+	case includesTo:
+		return BoundsOpenClosed
+	}
+	return BoundsOpen
+}
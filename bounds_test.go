@@ -0,0 +1,182 @@
+package synchro
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/Code-Hex/synchro/tz"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestParseBounds(t *testing.T) {
+	for _, b := range []Bounds{BoundsClosed, BoundsClosedOpen, BoundsOpenClosed, BoundsOpen} {
+		got, err := ParseBounds(b.String())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != b {
+			t.Errorf("want %s but got %s", b, got)
+		}
+	}
+	if _, err := ParseBounds("[["); err == nil {
+		t.Error("want error")
+	}
+	if got := Bounds(10).String(); got != "Bounds(10)" {
+		t.Errorf("unexpected string: %q", got)
+	}
+}
+
+func TestPeriod_Bounds(t *testing.T) {
+	from := New[tz.UTC](2023, 1, 1, 0, 0, 0, 0)
+	mid := New[tz.UTC](2023, 1, 2, 0, 0, 0, 0)
+	to := New[tz.UTC](2023, 1, 3, 0, 0, 0, 0)
+	p := mustPeriod(t, "2023-01-01", "2023-01-03")
+	cases := []struct {
+		bounds   Bounds
+		contains [3]int
+		periodic []Time[tz.UTC]
+		reversed []Time[tz.UTC]
+	}{
+		{
+			bounds:   BoundsClosed,
+			contains: [3]int{0, 1, 0},
+			periodic: []Time[tz.UTC]{from, mid, to},
+			reversed: []Time[tz.UTC]{to, mid, from},
+		},
+		{
+			bounds:   BoundsClosedOpen,
+			contains: [3]int{0, 1, -1},
+			periodic: []Time[tz.UTC]{from, mid},
+			reversed: []Time[tz.UTC]{to, mid},
+		},
+		{
+			bounds:   BoundsOpenClosed,
+			contains: [3]int{-1, 1, 0},
+			periodic: []Time[tz.UTC]{mid, to},
+			reversed: []Time[tz.UTC]{mid, from},
+		},
+		{
+			bounds:   BoundsOpen,
+			contains: [3]int{-1, 1, -1},
+			periodic: []Time[tz.UTC]{mid},
+			reversed: []Time[tz.UTC]{mid},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.bounds.String(), func(t *testing.T) {
+			p := p.WithBounds(tc.bounds)
+			if got := p.Bounds(); got != tc.bounds {
+				t.Errorf("want %s but got %s", tc.bounds, got)
+			}
+			got := [3]int{p.Contains(from), p.Contains(mid), p.Contains(to)}
+			if diff := cmp.Diff(tc.contains, got); diff != "" {
+				t.Errorf("Contains: (-want, +got)\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.periodic, slices.Collect(p.PeriodicDurationSeq(24*time.Hour))); diff != "" {
+				t.Errorf("PeriodicDurationSeq: (-want, +got)\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.periodic, p.PeriodicDuration(24*time.Hour).Slice()); diff != "" {
+				t.Errorf("PeriodicDuration: (-want, +got)\n%s", diff)
+			}
+			// The first character of the bounds is for from.
+			r := Period[tz.UTC]{from: to, to: from, bounds: tc.bounds}
+			if diff := cmp.Diff(tc.reversed, slices.Collect(r.PeriodicDurationSeq(-24*time.Hour))); diff != "" {
+				t.Errorf("reversed: (-want, +got)\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestPeriod_IsEmpty(t *testing.T) {
+	p := mustPeriod(t, "2023-01-01", "2023-01-01")
+	cases := map[Bounds]bool{
+		BoundsClosed:     false,
+		BoundsClosedOpen: true,
+		BoundsOpenClosed: true,
+		BoundsOpen:       true,
+	}
+	for b, want := range cases {
+		if got := p.WithBounds(b).IsEmpty(); got != want {
+			t.Errorf("%s: want %v but got %v", b, want, got)
+		}
+	}
+	if mustPeriod(t, "2023-01-01", "2023-01-02").WithBounds(BoundsOpen).IsEmpty() {
+		t.Error("want false for the non-empty open period")
+	}
+}
+
+func TestPeriod_SetOperationsWithBounds(t *testing.T) {
+	// Daily buckets.
+	day1 := mustPeriod(t, "2023-01-01", "2023-01-02").WithBounds(BoundsClosedOpen)
+	day2 := mustPeriod(t, "2023-01-02", "2023-01-03").WithBounds(BoundsClosedOpen)
+	open1 := day1.WithBounds(BoundsOpen)
+	open2 := day2.WithBounds(BoundsOpen)
+
+	if day1.Overlaps(day2) {
+		t.Error("want [) buckets not to overlap")
+	}
+	if !day1.Abuts(day2) || !day2.Abuts(day1) {
+		t.Error("want [) buckets to abut")
+	}
+	if open1.Abuts(open2) {
+		t.Error("want () periods not to abut as the instant between them is excluded")
+	}
+	if got, want := day1.Relation(day2), RelationMeets; got != want {
+		t.Errorf("want %s but got %s", want, got)
+	}
+	if got, want := open1.Relation(open2), RelationBefore; got != want {
+		t.Errorf("want %s but got %s", want, got)
+	}
+	if got, want := day1.Relation(day1.WithBounds(BoundsClosed)), RelationStarts; got != want {
+		t.Errorf("want %s but got %s", want, got)
+	}
+	if got, want := day1.WithBounds(BoundsOpenClosed).Relation(day1.WithBounds(BoundsClosed)), RelationFinishes; got != want {
+		t.Errorf("want %s but got %s", want, got)
+	}
+
+	u, ok := day1.Union(day2)
+	if !ok {
+		t.Fatal("want union of [) buckets")
+	}
+	if want := mustPeriod(t, "2023-01-01", "2023-01-03").WithBounds(BoundsClosedOpen); !u.Equal(want) {
+		t.Errorf("want %s but got %s", want, u)
+	}
+	if _, ok := open1.Union(open2); ok {
+		t.Error("want no union of () periods with the instant between them")
+	}
+	if _, ok := day1.Gap(day2); ok {
+		t.Error("want no gap between [) buckets")
+	}
+	gap, ok := open1.Gap(open2)
+	if !ok {
+		t.Fatal("want gap between () periods")
+	}
+	if want := mustPeriod(t, "2023-01-02", "2023-01-02"); !gap.Equal(want) {
+		t.Errorf("want %s but got %s", want, gap)
+	}
+	if _, ok := day1.Intersect(day2); ok {
+		t.Error("want no intersection of [) buckets")
+	}
+	if diff := cmp.Diff([]Period[tz.UTC]{day1}, day1.Subtract(day2)); diff != "" {
+		t.Errorf("Subtract: (-want, +got)\n%s", diff)
+	}
+
+	s := NewPeriodSet(day2, day1, mustPeriod(t, "2023-01-05", "2023-01-05").WithBounds(BoundsClosedOpen))
+	if diff := cmp.Diff([]Period[tz.UTC]{u}, s.Periods()); diff != "" {
+		t.Errorf("NewPeriodSet: (-want, +got)\n%s", diff)
+	}
+	if s.Contains(New[tz.UTC](2023, 1, 3, 0, 0, 0, 0)) {
+		t.Error("want the excluded end not to be contained")
+	}
+	if !s.Contains(New[tz.UTC](2023, 1, 2, 0, 0, 0, 0)) {
+		t.Error("want the start of the second bucket to be contained")
+	}
+	hole := NewPeriodSet(open1, open2)
+	if got := hole.Gaps().Periods(); len(got) != 1 || !got[0].Equal(gap) {
+		t.Errorf("want gap %s but got %v", gap, got)
+	}
+	if hole.Contains(New[tz.UTC](2023, 1, 2, 0, 0, 0, 0)) {
+		t.Error("want the instant between () periods not to be contained")
+	}
+}
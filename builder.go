@@ -2,6 +2,8 @@ package synchro
 
 import (
 	"time"
+
+	"github.com/Code-Hex/synchro/iso8601"
 )
 
 // Unit is a component of the time being built.
@@ -44,10 +46,40 @@ func (ns Nanosecond) cast() int { return int(ns) }
 // u1 is a required unit, while u2... can be provided as additional optional units.
 // This method returns a new Time[T] and does not modify the original.
 func (t Time[T]) Change(u1 Unit, u2 ...Unit) Time[T] {
+	return newFromLocal[T](t.changed(u1, u2...))
+}
+
+// ChangeStrict is like Change, but does not normalize the result.
+//
+// It returns *RangeError if any component of the result is out of its usual
+// range (e.g., Day(32) or Hour(24)), and *LocalTimeError if the resulting
+// wall clock time does not exist in the timezone T because of a daylight
+// saving time transition. A repeated wall clock time is resolved to the
+// earlier instant.
+func (t Time[T]) ChangeStrict(u1 Unit, u2 ...Unit) (Time[T], error) {
+	return newStrict[T](t.changed(u1, u2...))
+}
+
+// ChangeWithPolicy is like Change, but resolves a wall clock time which is
+// skipped or repeated in the timezone T according to the given policy.
+//
+// An error is returned only if policy is DSTReject.
+func (t Time[T]) ChangeWithPolicy(policy DSTPolicy, u1 Unit, u2 ...Unit) (Time[T], error) {
+	return LocalIn[T](t.changed(u1, u2...)).Resolve(policy)
+}
+
+// changed returns the wall clock of t with the provided unit values applied.
+// The result is not normalized, so it may have components out of range.
+func (t Time[T]) changed(u1 Unit, u2 ...Unit) LocalDateTime {
 	year, month, day := t.Date()
 	hour, min, sec := t.Clock()
 	nsec := t.Nanosecond()
-	for _, u := range append([]Unit{u1}, u2...) {
+	// Units are not appended to a slice to avoid allocation for each call.
+	for i := -1; i < len(u2); i++ {
+		u := u1
+		if i >= 0 {
+			u = u2[i]
+		}
 		switch v := u.(type) {
 		case Year:
 			year = v.cast()
@@ -65,7 +97,10 @@ func (t Time[T]) Change(u1 Unit, u2 ...Unit) Time[T] {
 			nsec = v.cast()
 		}
 	}
-	return New[T](year, month, day, hour, min, sec, nsec)
+	return LocalDateTime{
+		Date: iso8601.Date{Year: year, Month: month, Day: day},
+		Time: iso8601.Time{Hour: hour, Minute: min, Second: sec, Nanosecond: nsec},
+	}
 }
 
 // Advance adjusts the time based on the provided unit values, moving it forward in time.
@@ -73,9 +108,26 @@ func (t Time[T]) Change(u1 Unit, u2 ...Unit) Time[T] {
 // This method returns a new Time[T] and does not modify the original.
 // The time is adjusted in the order the units are provided.
 func (t Time[T]) Advance(u1 Unit, u2 ...Unit) Time[T] {
+	return newFromLocal[T](t.advanced(u1, u2...))
+}
+
+// AdvanceWithPolicy is like Advance, but resolves a wall clock time which is
+// skipped or repeated in the timezone T according to the given policy.
+//
+// An error is returned only if policy is DSTReject.
+func (t Time[T]) AdvanceWithPolicy(policy DSTPolicy, u1 Unit, u2 ...Unit) (Time[T], error) {
+	return LocalIn[T](t.advanced(u1, u2...)).Resolve(policy)
+}
+
+func (t Time[T]) advanced(u1 Unit, u2 ...Unit) LocalDateTime {
 	ret := t
 	years, months, days := 0, time.Month(0), 0
-	for _, u := range append([]Unit{u1}, u2...) {
+	// Units are not appended to a slice to avoid allocation for each call.
+	for i := -1; i < len(u2); i++ {
+		u := u1
+		if i >= 0 {
+			u = u2[i]
+		}
 		switch v := u.(type) {
 		case Year:
 			years += v.cast()
@@ -95,5 +147,12 @@ func (t Time[T]) Advance(u1 Unit, u2 ...Unit) Time[T] {
 	}
 	year, month, day := ret.Date()
 	hour, min, sec := ret.Clock()
-	return New[T](year+years, month+months, day+days, hour, min, sec, ret.Nanosecond())
+	return NewLocalDateTime(year+years, month+months, day+days, hour, min, sec, ret.Nanosecond())
+}
+
+func newFromLocal[T TimeZone](l LocalDateTime) Time[T] {
+	return New[T](
+		l.Date.Year, l.Date.Month, l.Date.Day,
+		l.Time.Hour, l.Time.Minute, l.Time.Second, l.Time.Nanosecond,
+	)
 }
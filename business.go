@@ -0,0 +1,88 @@
+package synchro
+
+import (
+	"time"
+
+	"github.com/Code-Hex/synchro/iso8601"
+)
+
+// Calendar defines which days are business days.
+//
+// A day is a business day if it is neither a weekend nor a holiday.
+// The holiday package provides a rule-based implementation.
+type Calendar interface {
+	// IsWeekend reports whether the weekday is a weekend.
+	IsWeekend(weekday time.Weekday) bool
+
+	// IsHoliday reports whether the date is a holiday.
+	IsHoliday(date iso8601.Date) bool
+}
+
+func isBusinessDay(cal Calendar, d iso8601.Date) bool {
+	return !cal.IsWeekend(d.StdTime().Weekday()) && !cal.IsHoliday(d)
+}
+
+// IsBusinessDay reports whether the date in which t occurs is a business day in cal.
+func (t Time[T]) IsBusinessDay(cal Calendar) bool {
+	return isBusinessDay(cal, iso8601.DateOf(t.tm))
+}
+
+// NextBusinessDay returns the time on the first business day after the date
+// in which t occurs. The time of day is kept.
+//
+// It is equivalent to t.AddBusinessDays(cal, 1).
+func (t Time[T]) NextBusinessDay(cal Calendar) Time[T] {
+	return t.AddBusinessDays(cal, 1)
+}
+
+// AddBusinessDays returns the time on the date n business days after the date in
+// which t occurs. n can also be negative to go into the past. The time of day is kept.
+//
+// The date in which t occurs does not need to be a business day. For example,
+// one business day after Saturday is Monday if it is not a holiday.
+// If n is zero, t is returned if it is on a business day; otherwise, the time
+// on the next business day is returned.
+//
+// cal must have at least one business day, otherwise AddBusinessDays never returns.
+func (t Time[T]) AddBusinessDays(cal Calendar, n int) Time[T] {
+	d := iso8601.DateOf(t.tm)
+	if n == 0 {
+		if isBusinessDay(cal, d) {
+			return t
+		}
+		n = 1
+	}
+	step := 1
+	if n < 0 {
+		step, n = -1, -n
+	}
+	for n > 0 {
+		d = d.AddDays(step)
+		if isBusinessDay(cal, d) {
+			n--
+		}
+	}
+	hour, min, sec := t.Clock()
+	return New[T](d.Year, d.Month, d.Day, hour, min, sec, t.Nanosecond())
+}
+
+// BusinessDaysBetween returns the number of business days from the date in which t
+// occurs to the date in which u occurs, including the former and excluding the latter.
+// If u is before t, the result is negative.
+//
+// For example, from Monday to Friday of the same week without holidays is 4.
+func (t Time[T]) BusinessDaysBetween(cal Calendar, u Time[T]) int {
+	from, to := iso8601.DateOf(t.tm), iso8601.DateOf(u.tm)
+	sign := 1
+	if to.Before(from) {
+		from, to = to, from
+		sign = -1
+	}
+	count := 0
+	for d := from; d.Before(to); d = d.AddDays(1) {
+		if isBusinessDay(cal, d) {
+			count++
+		}
+	}
+	return sign * count
+}
@@ -0,0 +1,132 @@
+package synchro_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/Code-Hex/synchro"
+	"github.com/Code-Hex/synchro/holiday"
+	"github.com/Code-Hex/synchro/iso8601"
+	"github.com/Code-Hex/synchro/tz"
+)
+
+var _ synchro.Calendar = (*holiday.Calendar)(nil)
+
+// weekendOnly is a calendar without holidays except for the given date.
+type weekendOnly struct {
+	holiday iso8601.Date
+}
+
+func (weekendOnly) IsWeekend(wd time.Weekday) bool {
+	return wd == time.Saturday || wd == time.Sunday
+}
+
+func (w weekendOnly) IsHoliday(d iso8601.Date) bool {
+	return d == w.holiday
+}
+
+func TestTime_AddBusinessDays(t *testing.T) {
+	// 2023-09-18 (Mon) is a holiday.
+	cal := weekendOnly{holiday: iso8601.Date{Year: 2023, Month: time.September, Day: 18}}
+	tests := []struct {
+		name string
+		from synchro.Time[tz.AsiaTokyo]
+		n    int
+		want synchro.Time[tz.AsiaTokyo]
+	}{
+		{
+			name: "same week",
+			from: synchro.New[tz.AsiaTokyo](2023, 9, 11, 10, 0, 0, 0),
+			n:    3,
+			want: synchro.New[tz.AsiaTokyo](2023, 9, 14, 10, 0, 0, 0),
+		},
+		{
+			name: "over the weekend and holiday",
+			from: synchro.New[tz.AsiaTokyo](2023, 9, 15, 10, 0, 0, 0),
+			n:    1,
+			want: synchro.New[tz.AsiaTokyo](2023, 9, 19, 10, 0, 0, 0),
+		},
+		{
+			name: "from Saturday",
+			from: synchro.New[tz.AsiaTokyo](2023, 9, 9, 10, 0, 0, 0),
+			n:    1,
+			want: synchro.New[tz.AsiaTokyo](2023, 9, 11, 10, 0, 0, 0),
+		},
+		{
+			name: "backward",
+			from: synchro.New[tz.AsiaTokyo](2023, 9, 19, 10, 0, 0, 0),
+			n:    -2,
+			want: synchro.New[tz.AsiaTokyo](2023, 9, 14, 10, 0, 0, 0),
+		},
+		{
+			name: "zero on business day",
+			from: synchro.New[tz.AsiaTokyo](2023, 9, 14, 10, 0, 0, 0),
+			n:    0,
+			want: synchro.New[tz.AsiaTokyo](2023, 9, 14, 10, 0, 0, 0),
+		},
+		{
+			name: "zero on holiday",
+			from: synchro.New[tz.AsiaTokyo](2023, 9, 16, 10, 0, 0, 0),
+			n:    0,
+			want: synchro.New[tz.AsiaTokyo](2023, 9, 19, 10, 0, 0, 0),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.from.AddBusinessDays(cal, tt.n)
+			if !tt.want.Equal(got) {
+				t.Errorf("want %s but got %s", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestTime_IsBusinessDay(t *testing.T) {
+	cal := weekendOnly{holiday: iso8601.Date{Year: 2023, Month: time.September, Day: 18}}
+	// 2023-09-17 15:30 UTC is 2023-09-18 00:30 in Tokyo.
+	utc := synchro.New[tz.UTC](2023, 9, 17, 15, 30, 0, 0)
+	if utc.IsBusinessDay(cal) {
+		t.Error("want Sunday in UTC to be non-business day")
+	}
+	if synchro.ConvertTz[tz.AsiaTokyo](utc).IsBusinessDay(cal) {
+		t.Error("want holiday in Tokyo to be non-business day")
+	}
+	if !synchro.ConvertTz[tz.AmericaNew_York](utc).AddDate(0, 0, -2).IsBusinessDay(cal) {
+		t.Error("want Friday in New York to be business day")
+	}
+}
+
+func TestTime_BusinessDaysBetween(t *testing.T) {
+	cal := weekendOnly{holiday: iso8601.Date{Year: 2023, Month: time.September, Day: 18}}
+	mon := synchro.New[tz.UTC](2023, 9, 11, 0, 0, 0, 0)
+	tests := []struct {
+		name string
+		to   synchro.Time[tz.UTC]
+		want int
+	}{
+		{name: "same day", to: mon.Add(time.Hour), want: 0},
+		{name: "Monday to Friday", to: mon.AddDate(0, 0, 4), want: 4},
+		{name: "two weeks with a holiday", to: mon.AddDate(0, 0, 14), want: 9},
+		{name: "backward", to: mon.AddDate(0, 0, -7), want: -5},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := mon.BusinessDaysBetween(cal, tt.to); got != tt.want {
+				t.Errorf("want %d but got %d", tt.want, got)
+			}
+		})
+	}
+}
+
+func ExampleTime_AddBusinessDays() {
+	cal := holiday.Japan()
+	// The invoice is due 3 business days after 2023-04-28 in Japan,
+	// skipping Golden Week.
+	issued := synchro.New[tz.AsiaTokyo](2023, 4, 28, 17, 0, 0, 0)
+	fmt.Println(issued.AddBusinessDays(cal, 3))
+	fmt.Println(issued.NextBusinessDay(cal))
+	// Output:
+	// 2023-05-08 17:00:00 +0900 JST
+	// 2023-05-01 17:00:00 +0900 JST
+}
@@ -0,0 +1,123 @@
+package synchro
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+)
+
+// Clock provides the current time and timers.
+//
+// Implementations other than SystemClock are useful for testing the logic
+// which depends on the current time.
+type Clock interface {
+	// Now returns the current time.
+	Now() time.Time
+
+	// After waits for the duration to elapse and then sends the current time
+	// on the returned channel.
+	After(d time.Duration) <-chan time.Time
+}
+
+// TimerClock is a Clock which can also call a function after a duration, as
+// time.AfterFunc does. Timers created from a TimerClock wait without
+// a goroutine.
+type TimerClock interface {
+	Clock
+
+	// AfterFunc waits for the duration to elapse and then calls f in its own
+	// goroutine. The returned function cancels the call as time.Timer.Stop:
+	// it returns true if the call is cancelled, false if f has already been
+	// called or the call has already been cancelled.
+	AfterFunc(d time.Duration, f func()) (stop func() bool)
+}
+
+// SystemClock is a Clock backed by the system clock.
+type SystemClock struct{}
+
+var _ TimerClock = SystemClock{}
+
+// Now returns time.Now().
+func (SystemClock) Now() time.Time { return time.Now() }
+
+// After returns time.After(d).
+func (SystemClock) After(d time.Duration) <-chan time.Time { return time.After(d) }
+
+// AfterFunc calls f by time.AfterFunc, and returns the Stop method of the
+// timer.
+func (SystemClock) AfterFunc(d time.Duration, f func()) func() bool {
+	return time.AfterFunc(d, f).Stop
+}
+
+// clockHolder wraps a Clock so that clocks of different types can be stored
+// in an atomic.Pointer.
+type clockHolder struct{ c Clock }
+
+// defaultClock is used by Now and After. Nil means SystemClock.
+var defaultClock atomic.Pointer[clockHolder]
+
+// DefaultClock returns the clock used by Now and After.
+func DefaultClock() Clock {
+	if h := defaultClock.Load(); h != nil {
+		return h.c
+	}
+	return SystemClock{}
+}
+
+// SetDefaultClock sets the clock used by Now and After, and returns the
+// previous one. Nil restores SystemClock. It is safe for concurrent use, but
+// it affects the whole program, so prefer NowFrom, AfterFrom or WithClock
+// where the clock can be passed explicitly.
+//
+// In tests, the previous clock can be restored as follows:
+//
+//	defer synchro.SetDefaultClock(synchro.SetDefaultClock(c))
+func SetDefaultClock(c Clock) Clock {
+	var h *clockHolder
+	if c != nil {
+		h = &clockHolder{c: c}
+	}
+	if prev := defaultClock.Swap(h); prev != nil {
+		return prev.c
+	}
+	return SystemClock{}
+}
+
+// NowFrom returns the current time of the clock with timezone.
+func NowFrom[T TimeZone](c Clock) Time[T] {
+	return In[T](c.Now())
+}
+
+// AfterFrom waits for the duration to elapse on the clock and then sends
+// the current time with timezone on the returned channel.
+//
+// If the clock is a TimerClock such as SystemClock, no goroutine waits for
+// the duration. Otherwise, a goroutine waits on the channel returned by
+// After of the clock, and it is never released if the channel never fires.
+func AfterFrom[T TimeZone](c Clock, d time.Duration) <-chan Time[T] {
+	if tc, ok := c.(TimerClock); ok {
+		return NewTimerFrom[T](tc, d).C
+	}
+	ch := make(chan Time[T], 1)
+	go func() { ch <- In[T](<-c.After(d)) }()
+	return ch
+}
+
+type clockContextKey struct{}
+
+// WithClock returns a new context with the provided clock stored in it.
+//
+// NowContext falls back to the clock if no time is stored by NowWithContext.
+func WithClock(ctx context.Context, c Clock) context.Context {
+	return context.WithValue(ctx, clockContextKey{}, c)
+}
+
+// ClockContext returns the clock stored in the provided context.
+// If the clock is not found in the context, it returns SystemClock.
+func ClockContext(ctx context.Context) Clock {
+	c, ok := ctx.Value(clockContextKey{}).(Clock)
+	if !ok {
+		return SystemClock{}
+	}
+	return c
+}
@@ -0,0 +1,134 @@
+package synchro_test
+
+import (
+	"context"
+	"fmt"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/Code-Hex/synchro"
+	"github.com/Code-Hex/synchro/tz"
+)
+
+// fixedClock is a Clock whose current time never changes.
+// After fires immediately with the time d after the fixed time.
+type fixedClock time.Time
+
+var _ synchro.Clock = fixedClock{}
+
+func (c fixedClock) Now() time.Time { return time.Time(c) }
+
+func (c fixedClock) After(d time.Duration) <-chan time.Time {
+	ch := make(chan time.Time, 1)
+	ch <- time.Time(c).Add(d)
+	return ch
+}
+
+func TestNowFrom(t *testing.T) {
+	c := fixedClock(time.Date(2023, 9, 2, 14, 0, 0, 0, time.UTC))
+	got := synchro.NowFrom[tz.AsiaTokyo](c)
+	want := synchro.New[tz.AsiaTokyo](2023, 9, 2, 23, 0, 0, 0)
+	if !want.Equal(got) {
+		t.Errorf("want %s but got %s", want, got)
+	}
+	if loc := got.Location().String(); loc != "Asia/Tokyo" {
+		t.Errorf("want Asia/Tokyo but got %s", loc)
+	}
+}
+
+func TestAfterFrom(t *testing.T) {
+	c := fixedClock(time.Date(2023, 9, 2, 14, 0, 0, 0, time.UTC))
+	select {
+	case got := <-synchro.AfterFrom[tz.UTC](c, time.Hour):
+		want := synchro.New[tz.UTC](2023, 9, 2, 15, 0, 0, 0)
+		if !want.Equal(got) {
+			t.Errorf("want %s but got %s", want, got)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("timeout")
+	}
+}
+
+func TestAfterFrom_TimerClock(t *testing.T) {
+	c := newManualClock()
+	before := runtime.NumGoroutine()
+	ch := synchro.AfterFrom[tz.AsiaTokyo](c, time.Hour)
+	if after := runtime.NumGoroutine(); after > before {
+		t.Errorf("want no new goroutines but got %d -> %d", before, after)
+	}
+	now := time.Date(2023, 9, 2, 14, 0, 0, 0, time.UTC)
+	c.fire()(now)
+	select {
+	case got := <-ch:
+		if want := synchro.In[tz.AsiaTokyo](now); !want.Equal(got) {
+			t.Errorf("want %s but got %s", want, got)
+		}
+	default:
+		t.Fatal("want the value after the clock fired")
+	}
+}
+
+func TestSetDefaultClock(t *testing.T) {
+	c := fixedClock(time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC))
+	prev := synchro.SetDefaultClock(c)
+	defer synchro.SetDefaultClock(prev)
+
+	if got := synchro.DefaultClock(); got != c {
+		t.Errorf("want %v but got %v", c, got)
+	}
+	if got, want := synchro.Now[tz.AsiaTokyo](), synchro.New[tz.AsiaTokyo](2024, 1, 2, 12, 4, 5, 0); !want.Equal(got) {
+		t.Errorf("want %s but got %s", want, got)
+	}
+	select {
+	case got := <-synchro.After[tz.UTC](time.Hour):
+		if want := synchro.New[tz.UTC](2024, 1, 2, 4, 4, 5, 0); !want.Equal(got) {
+			t.Errorf("want %s but got %s", want, got)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("timeout")
+	}
+
+	if got := synchro.SetDefaultClock(nil); got != c {
+		t.Errorf("want %v but got %v", c, got)
+	}
+	if _, ok := synchro.DefaultClock().(synchro.SystemClock); !ok {
+		t.Errorf("want SystemClock but got %T", synchro.DefaultClock())
+	}
+}
+
+func TestClockContext(t *testing.T) {
+	ctx := context.Background()
+	if _, ok := synchro.ClockContext(ctx).(synchro.SystemClock); !ok {
+		t.Errorf("want SystemClock but got %T", synchro.ClockContext(ctx))
+	}
+	if got := synchro.NowContext[tz.UTC](ctx); !got.IsZero() {
+		t.Errorf("want zero but got %s", got)
+	}
+
+	c := fixedClock(time.Date(2023, 9, 2, 14, 0, 0, 0, time.UTC))
+	ctx = synchro.WithClock(ctx, c)
+	if got := synchro.ClockContext(ctx); got != c {
+		t.Errorf("want %v but got %v", c, got)
+	}
+	if got, want := synchro.NowContext[tz.UTC](ctx), synchro.New[tz.UTC](2023, 9, 2, 14, 0, 0, 0); !want.Equal(got) {
+		t.Errorf("want %s but got %s", want, got)
+	}
+
+	// The time stored by NowWithContext takes precedence over the clock.
+	fixed := synchro.New[tz.UTC](2000, 1, 1, 0, 0, 0, 0)
+	ctx = synchro.NowWithContext(ctx, fixed)
+	if got := synchro.NowContext[tz.UTC](ctx); !fixed.Equal(got) {
+		t.Errorf("want %s but got %s", fixed, got)
+	}
+}
+
+func ExampleWithClock() {
+	c := fixedClock(time.Date(2023, 9, 2, 14, 0, 0, 0, time.UTC))
+	ctx := synchro.WithClock(context.Background(), c)
+	fmt.Println(synchro.NowContext[tz.UTC](ctx))
+	fmt.Println(synchro.NowContext[tz.AsiaTokyo](ctx))
+	// Output:
+	// 2023-09-02 14:00:00 +0000 UTC
+	// 2023-09-02 23:00:00 +0900 JST
+}
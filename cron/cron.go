@@ -0,0 +1,290 @@
+// Package cron implements cron expressions evaluated on the wall clock of
+// the timezone T of synchro.Time[T].
+//
+// An expression has 5 fields (minute, hour, day of month, month and day of
+// week) or 6 fields with a leading second field. Each field accepts "*",
+// values, ranges "a-b", steps "*/n", "a/n", "a-b/n" and lists separated by
+// commas. Months and days of week also accept names such as "JAN" and "MON".
+// Days of week are 0-7 where both 0 and 7 are Sunday.
+//
+// The following Quartz extensions are supported:
+//
+//   - "?" is the same as "*" in the day of month and day of week fields.
+//   - "L" is the last day of the month, and "L-n" is n days before it.
+//   - "nW" is the weekday (Monday to Friday) nearest to the nth day of the month
+//     within the same month, and "LW" is the last weekday of the month.
+//   - "nL" in the day of week field is the last weekday n of the month, e.g. "5L".
+//   - "n#k" in the day of week field is the kth weekday n of the month, e.g. "5#3".
+//
+// As in Vixie cron, if both of the day of month and day of week fields are
+// restricted (neither starts with "*" or "?"), a day matches if either field
+// matches. The macros @yearly, @annually, @monthly, @weekly, @daily,
+// @midnight and @hourly are also supported.
+//
+// # Daylight saving time
+//
+// Fire times are wall clock times in T, so "0 9 * * *" fires at 09:00 local
+// time every day regardless of DST transitions.
+//
+//   - A fire time in a gap (skipped wall clock time) fires shifted forward by the
+//     length of the gap. For example, "30 2 * * *" fires at 03:30 EDT on the day
+//     when 02:00 to 03:00 is skipped in America/New_York. Fire times which
+//     fall on the same instant fire once.
+//   - A fire time in an overlap (repeated wall clock time) fires once, at the
+//     first occurrence. For example, "*/15 1 * * *" fires at 01:00 EDT to 01:45 EDT
+//     and does not fire during 01:00 EST to 01:59 EST on the day when the clock
+//     falls back in America/New_York.
+package cron
+
+import (
+	"time"
+
+	"github.com/Code-Hex/synchro"
+)
+
+// searchDays bounds the search of fire times. It covers every combination of
+// the day of month and the day of week in the Gregorian calendar.
+const searchDays = 400 * 366
+
+// Schedule is a parsed cron expression evaluated in the timezone T.
+type Schedule[T synchro.TimeZone] struct {
+	expr string
+	spec *spec
+}
+
+// Parse parses a cron expression.
+func Parse[T synchro.TimeZone](expr string) (*Schedule[T], error) {
+	s, err := parse(expr)
+	if err != nil {
+		return nil, err
+	}
+	return &Schedule[T]{expr: expr, spec: s}, nil
+}
+
+// MustParse is like Parse but panics if the expression cannot be parsed.
+func MustParse[T synchro.TimeZone](expr string) *Schedule[T] {
+	s, err := Parse[T](expr)
+	if err != nil {
+		panic(err)
+	}
+	return s
+}
+
+// String returns the expression of the schedule.
+func (s *Schedule[T]) String() string { return s.expr }
+
+// Next returns the first fire time after t.
+// If there is no such time, it returns the zero value.
+func (s *Schedule[T]) Next(t synchro.Time[T]) synchro.Time[T] {
+	w := window(t.StdTime())
+	var (
+		best     synchro.Time[T]
+		bestWall time.Time
+		found    bool
+	)
+	c, ok := s.spec.nextWall(t.LocalDateTime().UTC().Add(-w - time.Nanosecond))
+	for ; ok; c, ok = s.spec.nextWall(c) {
+		// Fire times in a gap are shifted forward, so a later wall clock time
+		// can be an earlier instant within the length of the gap.
+		if found && c.After(bestWall.Add(w)) {
+			break
+		}
+		u := synchro.ResolveLocal[T](synchro.LocalDateTimeOf(c))
+		if !u.After(t) {
+			continue
+		}
+		if !found || u.Before(best) {
+			best, bestWall, found = u, c, true
+			w = window(u.StdTime())
+		}
+	}
+	return best
+}
+
+// Prev returns the last fire time before t.
+// If there is no such time, it returns the zero value.
+func (s *Schedule[T]) Prev(t synchro.Time[T]) synchro.Time[T] {
+	w := window(t.StdTime())
+	var (
+		best     synchro.Time[T]
+		bestWall time.Time
+		found    bool
+	)
+	c, ok := s.spec.prevWall(t.LocalDateTime().UTC().Add(w + time.Second))
+	for ; ok; c, ok = s.spec.prevWall(c) {
+		if found && c.Before(bestWall.Add(-w)) {
+			break
+		}
+		u := synchro.ResolveLocal[T](synchro.LocalDateTimeOf(c))
+		if !u.Before(t) {
+			continue
+		}
+		if !found || u.After(best) {
+			best, bestWall, found = u, c, true
+			w = window(u.StdTime())
+		}
+	}
+	return best
+}
+
+// Between returns the fire times within the range from from to to, inclusive.
+func (s *Schedule[T]) Between(from, to synchro.Time[T]) []synchro.Time[T] {
+	var ret []synchro.Time[T]
+	for t := s.Next(from.Add(-1)); !t.IsZero() && !t.After(to); t = s.Next(t) {
+		ret = append(ret, t)
+	}
+	return ret
+}
+
+// window returns the largest change of the UTC offset around t.
+func window(t time.Time) time.Duration {
+	_, offset := t.Zone()
+	_, before := t.Add(-48 * time.Hour).Zone()
+	_, after := t.Add(48 * time.Hour).Zone()
+	return time.Duration(max(abs(offset-before), abs(after-offset))) * time.Second
+}
+
+func abs(x int) int {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
+
+// nextWall returns the first matching wall clock time after c.
+// All wall clock times are represented in UTC.
+func (s *spec) nextWall(c time.Time) (time.Time, bool) {
+	start := c.Truncate(time.Second).Add(time.Second)
+	day := start.Truncate(24 * time.Hour)
+	first := true
+	for i := 0; i < searchDays; i, day, first = i+1, day.AddDate(0, 0, 1), false {
+		if !s.matchDay(day) {
+			continue
+		}
+		for h := 0; h < 24; h++ {
+			if !has(s.hour, h) || (first && h < start.Hour()) {
+				continue
+			}
+			for m := 0; m < 60; m++ {
+				if !has(s.minute, m) || (first && h == start.Hour() && m < start.Minute()) {
+					continue
+				}
+				for sec := 0; sec < 60; sec++ {
+					if !has(s.second, sec) || (first && h == start.Hour() && m == start.Minute() && sec < start.Second()) {
+						continue
+					}
+					return day.Add(time.Duration(h)*time.Hour + time.Duration(m)*time.Minute + time.Duration(sec)*time.Second), true
+				}
+			}
+		}
+	}
+	return time.Time{}, false
+}
+
+// prevWall returns the last matching wall clock time before c.
+func (s *spec) prevWall(c time.Time) (time.Time, bool) {
+	end := c.Truncate(time.Second)
+	if end.Equal(c) {
+		end = end.Add(-time.Second)
+	}
+	day := end.Truncate(24 * time.Hour)
+	first := true
+	for i := 0; i < searchDays; i, day, first = i+1, day.AddDate(0, 0, -1), false {
+		if !s.matchDay(day) {
+			continue
+		}
+		for h := 23; h >= 0; h-- {
+			if !has(s.hour, h) || (first && h > end.Hour()) {
+				continue
+			}
+			for m := 59; m >= 0; m-- {
+				if !has(s.minute, m) || (first && h == end.Hour() && m > end.Minute()) {
+					continue
+				}
+				for sec := 59; sec >= 0; sec-- {
+					if !has(s.second, sec) || (first && h == end.Hour() && m == end.Minute() && sec > end.Second()) {
+						continue
+					}
+					return day.Add(time.Duration(h)*time.Hour + time.Duration(m)*time.Minute + time.Duration(sec)*time.Second), true
+				}
+			}
+		}
+	}
+	return time.Time{}, false
+}
+
+func has(bits uint64, v int) bool {
+	return bits&(1<<uint(v)) != 0
+}
+
+// matchDay reports whether the date d matches the month, the day of month and
+// the day of week fields.
+func (s *spec) matchDay(d time.Time) bool {
+	if !has(s.month, int(d.Month())) {
+		return false
+	}
+	dom, dow := s.matchDom(d), s.matchDow(d)
+	if s.domStar || s.dowStar {
+		return dom && dow
+	}
+	return dom || dow
+}
+
+func (s *spec) matchDom(d time.Time) bool {
+	day, last := d.Day(), daysInMonth(d.Year(), d.Month())
+	if has(s.dom, day) {
+		return true
+	}
+	for _, n := range s.lastDays {
+		if day == last-n {
+			return true
+		}
+	}
+	for _, n := range s.weekdays {
+		if n <= last && day == nearestWeekday(d, n, last) {
+			return true
+		}
+	}
+	if s.lastWeekday && day == nearestWeekday(d, last, last) {
+		return true
+	}
+	return false
+}
+
+// nearestWeekday returns the weekday nearest to the nth day of the month of d
+// within the month.
+func nearestWeekday(d time.Time, n, last int) int {
+	switch d.AddDate(0, 0, n-d.Day()).Weekday() {
+	case time.Saturday:
+		if n == 1 {
+			return n + 2
+		}
+		return n - 1
+	case time.Sunday:
+		if n == last {
+			return n - 2
+		}
+		return n + 1
+	}
+	return n
+}
+
+func (s *spec) matchDow(d time.Time) bool {
+	wd := d.Weekday()
+	if has(s.dow, int(wd)) {
+		return true
+	}
+	if s.lastDow[wd] && d.Day()+7 > daysInMonth(d.Year(), d.Month()) {
+		return true
+	}
+	for _, n := range s.nthDow[wd] {
+		if (d.Day()-1)/7+1 == n {
+			return true
+		}
+	}
+	return false
+}
+
+func daysInMonth(year int, month time.Month) int {
+	return time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
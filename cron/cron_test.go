@@ -0,0 +1,247 @@
+package cron_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/Code-Hex/synchro"
+	"github.com/Code-Hex/synchro/cron"
+	"github.com/Code-Hex/synchro/tz"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		expr    string
+		wantErr bool
+	}{
+		{expr: "* * * * *"},
+		{expr: "*/5 * * * * *"},
+		{expr: "0 9 * * MON-FRI"},
+		{expr: "0 0 L * ?"},
+		{expr: "0 0 15W,LW * ?"},
+		{expr: "0 0 ? * 5#3,FRIL"},
+		{expr: "@daily"},
+		{expr: "@every 1h", wantErr: true},
+		{expr: "* * * *", wantErr: true},
+		{expr: "* * * * * * *", wantErr: true},
+		{expr: "60 * * * *", wantErr: true},
+		{expr: "* 24 * * *", wantErr: true},
+		{expr: "* * 0 * *", wantErr: true},
+		{expr: "* * * 13 *", wantErr: true},
+		{expr: "* * * * 8", wantErr: true},
+		{expr: "5-1 * * * *", wantErr: true},
+		{expr: "*/0 * * * *", wantErr: true},
+		{expr: "* * * * 5#6", wantErr: true},
+		{expr: "* * 32W * *", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			_, err := cron.Parse[tz.UTC](tt.expr)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("want error %v but got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestSchedule_Next(t *testing.T) {
+	tests := []struct {
+		expr string
+		from string
+		want []string
+	}{
+		{
+			expr: "*/20 * * * *",
+			from: "2023-09-02T13:59:00Z",
+			want: []string{"2023-09-02T14:00:00Z", "2023-09-02T14:20:00Z", "2023-09-02T14:40:00Z"},
+		},
+		{
+			expr: "*/30 * * * * *",
+			from: "2023-09-02T13:59:59Z",
+			want: []string{"2023-09-02T14:00:00Z", "2023-09-02T14:00:30Z", "2023-09-02T14:01:00Z"},
+		},
+		{
+			expr: "0 9 * * MON-FRI",
+			from: "2023-09-01T09:00:00Z",
+			want: []string{"2023-09-04T09:00:00Z", "2023-09-05T09:00:00Z", "2023-09-06T09:00:00Z"},
+		},
+		{
+			expr: "@monthly",
+			from: "2023-11-15T00:00:00Z",
+			want: []string{"2023-12-01T00:00:00Z", "2024-01-01T00:00:00Z", "2024-02-01T00:00:00Z"},
+		},
+		{
+			expr: "0 0 L * ?",
+			from: "2024-01-31T00:00:00Z",
+			want: []string{"2024-02-29T00:00:00Z", "2024-03-31T00:00:00Z", "2024-04-30T00:00:00Z"},
+		},
+		{
+			expr: "0 0 L-2 * ?",
+			from: "2024-01-01T00:00:00Z",
+			want: []string{"2024-01-29T00:00:00Z", "2024-02-27T00:00:00Z", "2024-03-29T00:00:00Z"},
+		},
+		{
+			// 2023-09-01 is Friday, 2023-10-01 is Sunday, 2024-06-01 is Saturday.
+			expr: "0 0 1W 9,10 ?",
+			from: "2023-08-01T00:00:00Z",
+			want: []string{"2023-09-01T00:00:00Z", "2023-10-02T00:00:00Z", "2024-09-02T00:00:00Z"},
+		},
+		{
+			// 2023-09-30 is Saturday, 2023-12-31 is Sunday.
+			expr: "0 0 LW * ?",
+			from: "2023-09-01T00:00:00Z",
+			want: []string{"2023-09-29T00:00:00Z", "2023-10-31T00:00:00Z", "2023-11-30T00:00:00Z"},
+		},
+		{
+			expr: "0 0 ? * 5L",
+			from: "2023-09-01T00:00:00Z",
+			want: []string{"2023-09-29T00:00:00Z", "2023-10-27T00:00:00Z", "2023-11-24T00:00:00Z"},
+		},
+		{
+			expr: "0 0 ? * FRI#3",
+			from: "2023-09-01T00:00:00Z",
+			want: []string{"2023-09-15T00:00:00Z", "2023-10-20T00:00:00Z", "2023-11-17T00:00:00Z"},
+		},
+		{
+			// Either the 1st or Monday.
+			expr: "0 0 1 * 1",
+			from: "2023-09-01T00:00:00Z",
+			want: []string{"2023-09-04T00:00:00Z", "2023-09-11T00:00:00Z", "2023-09-18T00:00:00Z"},
+		},
+		{
+			expr: "0 0 29 2 *",
+			from: "2023-01-01T00:00:00Z",
+			want: []string{"2024-02-29T00:00:00Z", "2028-02-29T00:00:00Z", "2032-02-29T00:00:00Z"},
+		},
+		{
+			expr: "0 0 * * 7",
+			from: "2023-09-01T00:00:00Z",
+			want: []string{"2023-09-03T00:00:00Z", "2023-09-10T00:00:00Z", "2023-09-17T00:00:00Z"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			s := cron.MustParse[tz.UTC](tt.expr)
+			from, err := synchro.ParseISO[tz.UTC](tt.from)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var got []string
+			for i, cur := 0, from; i < len(tt.want); i++ {
+				cur = s.Next(cur)
+				got = append(got, cur.Format(time.RFC3339))
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("(-want, +got)\n%s", diff)
+			}
+
+			// Prev reverses Next.
+			last, err := synchro.ParseISO[tz.UTC](tt.want[len(tt.want)-1])
+			if err != nil {
+				t.Fatal(err)
+			}
+			got = got[:0]
+			for i, cur := 0, last; i < len(tt.want)-1; i++ {
+				cur = s.Prev(cur)
+				got = append([]string{cur.Format(time.RFC3339)}, got...)
+			}
+			if diff := cmp.Diff(tt.want[:len(tt.want)-1], got); diff != "" {
+				t.Errorf("Prev (-want, +got)\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestSchedule_Next_Never(t *testing.T) {
+	s := cron.MustParse[tz.UTC]("0 0 30 2 *")
+	if got := s.Next(synchro.New[tz.UTC](2023, 1, 1, 0, 0, 0, 0)); !got.IsZero() {
+		t.Errorf("want zero but got %s", got)
+	}
+	if got := s.Prev(synchro.New[tz.UTC](2023, 1, 1, 0, 0, 0, 0)); !got.IsZero() {
+		t.Errorf("want zero but got %s", got)
+	}
+}
+
+func TestSchedule_DST(t *testing.T) {
+	type NY = tz.AmericaNew_York
+	tests := []struct {
+		name string
+		expr string
+		from synchro.Time[NY]
+		to   synchro.Time[NY]
+		want []string
+	}{
+		{
+			name: "daily keeps wall clock",
+			expr: "0 9 * * *",
+			from: synchro.New[NY](2023, 3, 11, 0, 0, 0, 0),
+			to:   synchro.New[NY](2023, 3, 13, 0, 0, 0, 0),
+			want: []string{"2023-03-11T09:00:00-05:00", "2023-03-12T09:00:00-04:00"},
+		},
+		{
+			name: "skipped is shifted forward",
+			expr: "30 2 * * *",
+			from: synchro.New[NY](2023, 3, 11, 0, 0, 0, 0),
+			to:   synchro.New[NY](2023, 3, 14, 0, 0, 0, 0),
+			want: []string{"2023-03-11T02:30:00-05:00", "2023-03-12T03:30:00-04:00", "2023-03-13T02:30:00-04:00"},
+		},
+		{
+			name: "skipped and shifted fire once",
+			expr: "0 2,3 * * *",
+			from: synchro.New[NY](2023, 3, 12, 0, 0, 0, 0),
+			to:   synchro.New[NY](2023, 3, 12, 12, 0, 0, 0),
+			want: []string{"2023-03-12T03:00:00-04:00"},
+		},
+		{
+			name: "repeated fires once",
+			expr: "*/30 1 * * *",
+			from: synchro.New[NY](2023, 11, 5, 0, 0, 0, 0),
+			to:   synchro.New[NY](2023, 11, 5, 3, 0, 0, 0),
+			want: []string{"2023-11-05T01:00:00-04:00", "2023-11-05T01:30:00-04:00"},
+		},
+		{
+			name: "hourly across fall back",
+			expr: "0 * * * *",
+			from: synchro.New[NY](2023, 11, 5, 0, 0, 0, 0),
+			to:   synchro.New[NY](2023, 11, 5, 3, 0, 0, 0),
+			want: []string{
+				"2023-11-05T00:00:00-04:00", "2023-11-05T01:00:00-04:00",
+				"2023-11-05T02:00:00-05:00", "2023-11-05T03:00:00-05:00",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := cron.MustParse[NY](tt.expr)
+			var got []string
+			for _, tm := range s.Between(tt.from, tt.to) {
+				got = append(got, tm.Format(time.RFC3339))
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("(-want, +got)\n%s", diff)
+			}
+		})
+	}
+
+	// The second 01:30 is not a fire time, so Next from it goes to the next day.
+	s := cron.MustParse[NY]("30 1 * * *")
+	second, _ := synchro.LocalIn[NY](synchro.NewLocalDateTime(2023, 11, 5, 1, 30, 0, 0)).Latest()
+	if got, want := s.Next(second).Format(time.RFC3339), "2023-11-06T01:30:00-05:00"; got != want {
+		t.Errorf("want %s but got %s", want, got)
+	}
+	if got, want := s.Prev(second).Format(time.RFC3339), "2023-11-05T01:30:00-04:00"; got != want {
+		t.Errorf("want %s but got %s", want, got)
+	}
+}
+
+func ExampleSchedule_Next() {
+	s := cron.MustParse[tz.AsiaTokyo]("0 9 * * MON-FRI")
+	t := synchro.New[tz.AsiaTokyo](2023, 9, 1, 12, 0, 0, 0)
+	fmt.Println(s.Next(t))
+	fmt.Println(s.Prev(t))
+	// Output:
+	// 2023-09-04 09:00:00 +0900 JST
+	// 2023-09-01 09:00:00 +0900 JST
+}
@@ -0,0 +1,238 @@
+package cron
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+type bounds struct {
+	name     string
+	min, max int
+	names    map[string]int
+}
+
+var (
+	secondBounds = bounds{name: "second", min: 0, max: 59}
+	minuteBounds = bounds{name: "minute", min: 0, max: 59}
+	hourBounds   = bounds{name: "hour", min: 0, max: 23}
+	domBounds    = bounds{name: "day of month", min: 1, max: 31}
+	monthBounds  = bounds{name: "month", min: 1, max: 12, names: map[string]int{
+		"JAN": 1, "FEB": 2, "MAR": 3, "APR": 4, "MAY": 5, "JUN": 6,
+		"JUL": 7, "AUG": 8, "SEP": 9, "OCT": 10, "NOV": 11, "DEC": 12,
+	}}
+	// Both 0 and 7 are Sunday.
+	dowBounds = bounds{name: "day of week", min: 0, max: 7, names: map[string]int{
+		"SUN": 0, "MON": 1, "TUE": 2, "WED": 3, "THU": 4, "FRI": 5, "SAT": 6,
+	}}
+)
+
+var macros = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+// spec is a parsed cron expression.
+type spec struct {
+	second, minute, hour, dom, month, dow uint64
+
+	// domStar and dowStar report whether the field starts with "*" or "?".
+	domStar, dowStar bool
+
+	// lastDays holds offsets of "L" and "L-n" in the day of month field.
+	lastDays []int
+	// weekdays holds n of "nW" in the day of month field.
+	weekdays []int
+	// lastWeekday reports whether "LW" is in the day of month field.
+	lastWeekday bool
+
+	// lastDow reports for each weekday whether "nL" is in the day of week field.
+	lastDow [7]bool
+	// nthDow holds k of "n#k" for each weekday in the day of week field.
+	nthDow [7][]int
+}
+
+func parse(expr string) (*spec, error) {
+	fields := strings.Fields(expr)
+	if len(fields) == 1 && strings.HasPrefix(fields[0], "@") {
+		m, ok := macros[strings.ToLower(fields[0])]
+		if !ok {
+			return nil, fmt.Errorf("cron: unknown macro %q", fields[0])
+		}
+		fields = strings.Fields(m)
+	}
+	switch len(fields) {
+	case 5:
+		fields = append([]string{"0"}, fields...)
+	case 6:
+	default:
+		return nil, fmt.Errorf("cron: expected 5 or 6 fields but got %d: %q", len(fields), expr)
+	}
+	s := new(spec)
+	var err error
+	if s.second, err = parseField(fields[0], secondBounds); err != nil {
+		return nil, err
+	}
+	if s.minute, err = parseField(fields[1], minuteBounds); err != nil {
+		return nil, err
+	}
+	if s.hour, err = parseField(fields[2], hourBounds); err != nil {
+		return nil, err
+	}
+	if err := s.parseDom(fields[3]); err != nil {
+		return nil, err
+	}
+	if s.month, err = parseField(fields[4], monthBounds); err != nil {
+		return nil, err
+	}
+	if err := s.parseDow(fields[5]); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+func isStar(field string) bool {
+	return strings.HasPrefix(field, "*") || strings.HasPrefix(field, "?")
+}
+
+func (s *spec) parseDom(field string) error {
+	s.domStar = isStar(field)
+	for _, elem := range strings.Split(field, ",") {
+		upper := strings.ToUpper(elem)
+		switch {
+		case upper == "LW":
+			s.lastWeekday = true
+		case upper == "L":
+			s.lastDays = append(s.lastDays, 0)
+		case strings.HasPrefix(upper, "L-"):
+			n, err := strconv.Atoi(upper[2:])
+			if err != nil || n < 0 || n > 30 {
+				return fmt.Errorf("cron: invalid day of month %q", elem)
+			}
+			s.lastDays = append(s.lastDays, n)
+		case strings.HasSuffix(upper, "W"):
+			n, err := strconv.Atoi(upper[:len(upper)-1])
+			if err != nil || n < domBounds.min || n > domBounds.max {
+				return fmt.Errorf("cron: invalid day of month %q", elem)
+			}
+			s.weekdays = append(s.weekdays, n)
+		default:
+			bits, err := parseElem(elem, domBounds)
+			if err != nil {
+				return err
+			}
+			s.dom |= bits
+		}
+	}
+	return nil
+}
+
+func (s *spec) parseDow(field string) error {
+	s.dowStar = isStar(field)
+	for _, elem := range strings.Split(field, ",") {
+		upper := strings.ToUpper(elem)
+		switch {
+		case len(upper) > 1 && strings.HasSuffix(upper, "L"):
+			wd, err := parseValue(upper[:len(upper)-1], dowBounds)
+			if err != nil {
+				return err
+			}
+			s.lastDow[wd%7] = true
+		case strings.Contains(upper, "#"):
+			before, after, _ := strings.Cut(upper, "#")
+			wd, err := parseValue(before, dowBounds)
+			if err != nil {
+				return err
+			}
+			n, err := strconv.Atoi(after)
+			if err != nil || n < 1 || n > 5 {
+				return fmt.Errorf("cron: invalid day of week %q", elem)
+			}
+			s.nthDow[wd%7] = append(s.nthDow[wd%7], n)
+		default:
+			bits, err := parseElem(elem, dowBounds)
+			if err != nil {
+				return err
+			}
+			s.dow |= bits
+		}
+	}
+	// 7 is also Sunday.
+	if s.dow&(1<<7) != 0 {
+		s.dow = s.dow&^(1<<7) | 1
+	}
+	return nil
+}
+
+func parseField(field string, b bounds) (uint64, error) {
+	var bits uint64
+	for _, elem := range strings.Split(field, ",") {
+		v, err := parseElem(elem, b)
+		if err != nil {
+			return 0, err
+		}
+		bits |= v
+	}
+	return bits, nil
+}
+
+// parseElem parses "*", "?", "a", "a-b", "*/n", "a/n" or "a-b/n" to the bits.
+func parseElem(elem string, b bounds) (uint64, error) {
+	rng, stepStr, hasStep := strings.Cut(elem, "/")
+	step := 1
+	if hasStep {
+		var err error
+		step, err = strconv.Atoi(stepStr)
+		if err != nil || step < 1 {
+			return 0, fmt.Errorf("cron: invalid step in %s %q", b.name, elem)
+		}
+	}
+	var lo, hi int
+	switch {
+	case rng == "*" || rng == "?":
+		lo, hi = b.min, b.max
+	default:
+		before, after, isRange := strings.Cut(rng, "-")
+		var err error
+		if lo, err = parseValue(before, b); err != nil {
+			return 0, err
+		}
+		switch {
+		case isRange:
+			if hi, err = parseValue(after, b); err != nil {
+				return 0, err
+			}
+		case hasStep:
+			hi = b.max
+		default:
+			hi = lo
+		}
+	}
+	if lo > hi {
+		return 0, fmt.Errorf("cron: beginning of range is after the end in %s %q", b.name, elem)
+	}
+	var bits uint64
+	for v := lo; v <= hi; v += step {
+		bits |= 1 << uint(v)
+	}
+	return bits, nil
+}
+
+func parseValue(s string, b bounds) (int, error) {
+	if v, ok := b.names[strings.ToUpper(s)]; ok {
+		return v, nil
+	}
+	v, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, fmt.Errorf("cron: invalid %s %q", b.name, s)
+	}
+	if v < b.min || v > b.max {
+		return 0, fmt.Errorf("cron: %d %s is not in range %d-%d", v, b.name, b.min, b.max)
+	}
+	return v, nil
+}
@@ -0,0 +1,208 @@
+package synchro
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Code-Hex/synchro/iso8601"
+)
+
+// Date represents a calendar date in the timezone T.
+//
+// Unlike iso8601.Date, Date knows its timezone, so it can be converted to
+// the range of instants [StartOfDay, EndOfDay] it covers.
+type Date[T TimeZone] struct {
+	_ empty[T]
+	d iso8601.Date
+}
+
+// NewDate returns the Date corresponding to yyyy-mm-dd in the timezone T.
+//
+// The month and day values may be outside their usual ranges and will be
+// normalized during the conversion. For example, October 32 converts to November 1.
+func NewDate[T TimeZone](year int, month time.Month, day int) Date[T] {
+	return Date[T]{d: iso8601.DateOf(time.Date(year, month, day, 0, 0, 0, 0, time.UTC))}
+}
+
+// DateOf returns the Date in which t occurs in the timezone T.
+func DateOf[T TimeZone](t Time[T]) Date[T] {
+	return Date[T]{d: iso8601.DateOf(t.tm)}
+}
+
+// DateFromISO returns the Date in the timezone T corresponding to d.
+//
+// The components of d are normalized in the same way as NewDate.
+func DateFromISO[T TimeZone](d iso8601.Date) Date[T] {
+	return NewDate[T](d.Year, d.Month, d.Day)
+}
+
+// ParseDate parses an ISO8601-compliant date string and returns the Date it represents.
+// The value must be in a format accepted by iso8601.ParseDate.
+func ParseDate[T TimeZone](value string) (Date[T], error) {
+	dl, err := iso8601.ParseDate(value)
+	if err != nil {
+		return Date[T]{}, err
+	}
+	if err := dl.Validate(); err != nil {
+		return Date[T]{}, err
+	}
+	return Date[T]{d: dl.Date()}, nil
+}
+
+// ISODate returns d as iso8601.Date.
+func (d Date[T]) ISODate() iso8601.Date { return d.d }
+
+// Year returns the year in which d occurs.
+func (d Date[T]) Year() int { return d.d.Year }
+
+// Month returns the month of the year specified by d.
+func (d Date[T]) Month() time.Month { return d.d.Month }
+
+// Day returns the day of the month specified by d.
+func (d Date[T]) Day() int { return d.d.Day }
+
+// Weekday returns the day of the week specified by d.
+func (d Date[T]) Weekday() time.Weekday {
+	return d.d.StdTime().Weekday()
+}
+
+// YearDay returns the day of the year specified by d, in the range [1,365] for non-leap years,
+// and [1,366] in leap years.
+func (d Date[T]) YearDay() int {
+	return d.d.StdTime().YearDay()
+}
+
+// StartOfDay returns the first instant of d in the timezone T.
+//
+// This is usually 00:00:00 of d. If midnight was skipped by a daylight saving
+// time transition, the instant of the transition is returned. If midnight
+// was repeated, the earlier of the two instants is returned.
+func (d Date[T]) StartOfDay() Time[T] {
+	var tz T
+	civil := time.Date(d.d.Year, d.d.Month, d.d.Day, 0, 0, 0, 0, time.UTC)
+	earliest, _, n := localInstants(tz.Location(), civil)
+	if n == 0 {
+		// The transition is the end of the zone which was in effect before midnight.
+		_, end := earliest.ZoneBounds()
+		return In[T](end)
+	}
+	return In[T](earliest)
+}
+
+// EndOfDay returns the last instant of d in the timezone T.
+//
+// This is the nanosecond before the StartOfDay of the following day, so it is
+// correct even on days which are longer or shorter than 24 hours.
+func (d Date[T]) EndOfDay() Time[T] {
+	return d.AddDays(1).StartOfDay().Add(-1 * time.Nanosecond)
+}
+
+// AddDays returns the date that is n days after d.
+// n can also be negative to go into the past.
+func (d Date[T]) AddDays(n int) Date[T] {
+	return NewDate[T](d.d.Year, d.d.Month, d.d.Day+n)
+}
+
+// AddMonths returns the date that is n months after d.
+// n can also be negative to go into the past.
+//
+// Unlike Time.AddDate, the day is clamped to the last day of the resulting
+// month instead of overflowing. For example, January 31 plus one month
+// returns February 28 (or 29 in leap years).
+func (d Date[T]) AddMonths(n int) Date[T] {
+	first := NewDate[T](d.d.Year, d.d.Month+time.Month(n), 1)
+	day := d.d.Day
+	if last := daysIn(first.d.Year, first.d.Month); day > last {
+		day = last
+	}
+	return NewDate[T](first.d.Year, first.d.Month, day)
+}
+
+// DaysSince returns the signed number of days between d and u. (d-u)
+func (d Date[T]) DaysSince(u Date[T]) int {
+	return d.d.DaysSince(u.d)
+}
+
+// After reports whether d occurs after u.
+func (d Date[T]) After(u Date[T]) bool {
+	return d.d.After(u.d)
+}
+
+// Before reports whether d occurs before u.
+func (d Date[T]) Before(u Date[T]) bool {
+	return d.d.Before(u.d)
+}
+
+// Compare compares d with u. If d is before u, it returns -1;
+// if d is after u, it returns +1; if they're the same, it returns 0.
+func (d Date[T]) Compare(u Date[T]) int {
+	switch {
+	case d.d.Before(u.d):
+		return -1
+	case d.d.After(u.d):
+		return 1
+	}
+	return 0
+}
+
+// Equal reports whether d and u represent the same date.
+func (d Date[T]) Equal(u Date[T]) bool {
+	return d.d == u.d
+}
+
+// IsZero reports whether d is the zero value.
+func (d Date[T]) IsZero() bool {
+	return d.d.IsZero()
+}
+
+// String returns the ISO8601 string representation of the format "YYYY-MM-DD".
+// For example: "2012-12-01".
+func (d Date[T]) String() string {
+	return d.d.String()
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// The output is the result of d.String().
+func (d Date[T]) MarshalText() ([]byte, error) {
+	return d.d.MarshalText()
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// The date is expected to be a string in a format accepted by iso8601.ParseDate.
+func (d *Date[T]) UnmarshalText(data []byte) error {
+	dl, err := iso8601.ParseDate(data)
+	if err != nil {
+		return err
+	}
+	if err := dl.Validate(); err != nil {
+		return err
+	}
+	*d = Date[T]{d: dl.Date()}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// The date is a quoted string in the format "YYYY-MM-DD".
+func (d Date[T]) MarshalJSON() ([]byte, error) {
+	b := make([]byte, 0, len(`"2006-01-02"`))
+	b = append(b, '"')
+	b = append(b, d.String()...)
+	b = append(b, '"')
+	return b, nil
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// The date must be a quoted string in a format accepted by iso8601.ParseDate.
+func (d *Date[T]) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	if len(data) < 2 || data[0] != '"' || data[len(data)-1] != '"' {
+		return fmt.Errorf("synchro: Date.UnmarshalJSON: input is not a JSON string: %s", data)
+	}
+	return d.UnmarshalText(data[1 : len(data)-1])
+}
+
+func daysIn(year int, month time.Month) int {
+	return time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
@@ -0,0 +1,201 @@
+package synchro_test
+
+import (
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/Code-Hex/synchro"
+	"github.com/Code-Hex/synchro/iso8601"
+	"github.com/Code-Hex/synchro/tz"
+)
+
+var _ interface {
+	fmt.Stringer
+	json.Marshaler
+	json.Unmarshaler
+	encoding.TextMarshaler
+	encoding.TextUnmarshaler
+} = (*synchro.Date[tz.UTC])(nil)
+
+func TestDate_StartOfDay(t *testing.T) {
+	tests := []struct {
+		name string
+		got  synchro.Time[tz.AmericaSao_Paulo]
+		want string
+	}{
+		{
+			name: "ordinary day",
+			got:  synchro.NewDate[tz.AmericaSao_Paulo](2018, 11, 3).StartOfDay(),
+			want: "2018-11-03T00:00:00-03:00",
+		},
+		{
+			// DST started at 00:00, so clocks jumped to 01:00.
+			name: "midnight is skipped",
+			got:  synchro.NewDate[tz.AmericaSao_Paulo](2018, 11, 4).StartOfDay(),
+			want: "2018-11-04T01:00:00-02:00",
+		},
+		{
+			// DST ended at 00:00, so clocks went back to 23:00 of the previous day.
+			name: "the day after DST ended",
+			got:  synchro.NewDate[tz.AmericaSao_Paulo](2019, 2, 17).StartOfDay(),
+			want: "2019-02-17T00:00:00-03:00",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.got.Format(time.RFC3339); got != tt.want {
+				t.Errorf("want %s but got %s", tt.want, got)
+			}
+		})
+	}
+
+	t.Run("midnight is repeated", func(t *testing.T) {
+		// Cuba ends DST at 01:00 going back to 00:00, so midnight occurs twice.
+		got := synchro.NewDate[tz.AmericaHavana](2023, 11, 5).StartOfDay()
+		if want := "2023-11-05T00:00:00-04:00"; got.Format(time.RFC3339) != want {
+			t.Errorf("want %s but got %s", want, got.Format(time.RFC3339))
+		}
+	})
+}
+
+func TestDate_EndOfDay(t *testing.T) {
+	tests := []struct {
+		name string
+		date synchro.Date[tz.AmericaNew_York]
+		want string
+		hour time.Duration
+	}{
+		{
+			name: "ordinary day",
+			date: synchro.NewDate[tz.AmericaNew_York](2023, 3, 11),
+			want: "2023-03-11T23:59:59.999999999-05:00",
+			hour: 24,
+		},
+		{
+			name: "spring forward",
+			date: synchro.NewDate[tz.AmericaNew_York](2023, 3, 12),
+			want: "2023-03-12T23:59:59.999999999-04:00",
+			hour: 23,
+		},
+		{
+			name: "fall back",
+			date: synchro.NewDate[tz.AmericaNew_York](2023, 11, 5),
+			want: "2023-11-05T23:59:59.999999999-05:00",
+			hour: 25,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			end := tt.date.EndOfDay()
+			if got := end.Format(time.RFC3339Nano); got != tt.want {
+				t.Errorf("want %s but got %s", tt.want, got)
+			}
+			length := end.Sub(tt.date.StartOfDay()) + time.Nanosecond
+			if want := tt.hour * time.Hour; length != want {
+				t.Errorf("want length of day %v but got %v", want, length)
+			}
+		})
+	}
+}
+
+func TestDate_AddMonths(t *testing.T) {
+	tests := []struct {
+		date   synchro.Date[tz.UTC]
+		months int
+		want   string
+	}{
+		{synchro.NewDate[tz.UTC](2023, 1, 15), 1, "2023-02-15"},
+		{synchro.NewDate[tz.UTC](2023, 1, 31), 1, "2023-02-28"},
+		{synchro.NewDate[tz.UTC](2024, 1, 31), 1, "2024-02-29"},
+		{synchro.NewDate[tz.UTC](2023, 3, 31), -1, "2023-02-28"},
+		{synchro.NewDate[tz.UTC](2023, 12, 31), 2, "2024-02-29"},
+		{synchro.NewDate[tz.UTC](2023, 5, 31), -17, "2021-12-31"},
+	}
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%s%+d", tt.date, tt.months), func(t *testing.T) {
+			if got := tt.date.AddMonths(tt.months).String(); got != tt.want {
+				t.Errorf("want %s but got %s", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestDate_Compare(t *testing.T) {
+	d1 := synchro.NewDate[tz.UTC](2023, 9, 1)
+	d2 := synchro.NewDate[tz.UTC](2023, 9, 2)
+	if got := d1.Compare(d2); got != -1 {
+		t.Errorf("want -1 but got %d", got)
+	}
+	if got := d2.Compare(d1); got != 1 {
+		t.Errorf("want 1 but got %d", got)
+	}
+	if got := d1.Compare(d2.AddDays(-1)); got != 0 {
+		t.Errorf("want 0 but got %d", got)
+	}
+	if got := d2.DaysSince(d1); got != 1 {
+		t.Errorf("want 1 but got %d", got)
+	}
+}
+
+func TestDateOf(t *testing.T) {
+	tm := synchro.New[tz.AsiaTokyo](2023, 9, 2, 1, 0, 0, 0)
+	d := synchro.DateOf(tm)
+	if want := "2023-09-02"; d.String() != want {
+		t.Errorf("want %s but got %s", want, d)
+	}
+	if want := time.Saturday; d.Weekday() != want {
+		t.Errorf("want %s but got %s", want, d.Weekday())
+	}
+	// The same instant is on the previous day in UTC.
+	if want := "2023-09-01"; synchro.DateOf(synchro.ConvertTz[tz.UTC](tm)).String() != want {
+		t.Errorf("want %s but got %s", want, d)
+	}
+
+	iso := iso8601.Date{Year: 2023, Month: time.September, Day: 2}
+	if got := synchro.DateFromISO[tz.AsiaTokyo](iso); !got.Equal(d) {
+		t.Errorf("want %s but got %s", d, got)
+	}
+	if got := d.ISODate(); got != iso {
+		t.Errorf("want %s but got %s", iso, got)
+	}
+}
+
+func TestDate_JSON(t *testing.T) {
+	type v struct {
+		Date synchro.Date[tz.AsiaTokyo] `json:"date"`
+	}
+	want := v{Date: synchro.NewDate[tz.AsiaTokyo](2023, 9, 2)}
+	b, err := json.Marshal(want)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := `{"date":"2023-09-02"}`; string(b) != want {
+		t.Fatalf("want %s but got %s", want, b)
+	}
+	var got v
+	if err := json.Unmarshal(b, &got); err != nil {
+		t.Fatal(err)
+	}
+	if !got.Date.Equal(want.Date) {
+		t.Errorf("want %s but got %s", want.Date, got.Date)
+	}
+
+	for _, invalid := range []string{`{"date":"2023-02-30"}`, `{"date":20230901}`} {
+		if err := json.Unmarshal([]byte(invalid), &got); err == nil {
+			t.Errorf("want error for %s", invalid)
+		}
+	}
+}
+
+func ExampleDate_StartOfDay() {
+	// In New York, 2023-03-12 has only 23 hours because of DST.
+	d := synchro.NewDate[tz.AmericaNew_York](2023, 3, 12)
+	fmt.Println(d.StartOfDay())
+	fmt.Println(d.EndOfDay())
+	// Output:
+	// 2023-03-12 00:00:00 -0500 EST
+	// 2023-03-12 23:59:59.999999999 -0400 EDT
+}
@@ -0,0 +1,124 @@
+package synchro
+
+import (
+	"fmt"
+	"time"
+)
+
+// DSTPolicy determines how a wall clock time which is skipped or repeated
+// because of a daylight saving time transition is resolved to an instant.
+//
+// For example, in the United States, March 12, 2023 2:30am never occurred
+// because clocks jumped from 2:00am EST to 3:00am EDT, while November 5,
+// 2023 1:30am occurred twice, once in EDT and once in EST.
+type DSTPolicy int
+
+const (
+	// DSTShiftForward resolves a skipped wall clock time by shifting it forward
+	// by the length of the gap (2:30am EST is resolved to 3:30am EDT), and a
+	// repeated wall clock time to the earlier instant (1:30am EDT).
+	//
+	// This is the zero value of DSTPolicy.
+	DSTShiftForward DSTPolicy = iota
+
+	// DSTEarlier resolves a repeated wall clock time to the earlier instant
+	// (1:30am EDT), and a skipped wall clock time by shifting it backward by
+	// the length of the gap (2:30am is resolved to 1:30am EST).
+	DSTEarlier
+
+	// DSTLater resolves a repeated wall clock time to the later instant
+	// (1:30am EST), and a skipped wall clock time by shifting it forward by
+	// the length of the gap (2:30am is resolved to 3:30am EDT).
+	DSTLater
+
+	// DSTReject does not resolve a skipped or repeated wall clock time, and
+	// reports *LocalTimeError instead.
+	DSTReject
+)
+
+// String implements the fmt.Stringer interface.
+func (p DSTPolicy) String() string {
+	switch p {
+	case DSTShiftForward:
+		return "shift-forward"
+	case DSTEarlier:
+		return "earlier"
+	case DSTLater:
+		return "later"
+	case DSTReject:
+		return "reject"
+	}
+	return fmt.Sprintf("DSTPolicy(%d)", int(p))
+}
+
+// LocalTimeError indicates that a wall clock time is skipped or repeated in the
+// timezone, so that it cannot be resolved to a single instant.
+type LocalTimeError struct {
+	Local    LocalDateTime
+	Kind     LocalResultKind
+	Location string
+}
+
+// Error implements the error interface.
+func (e *LocalTimeError) Error() string {
+	return fmt.Sprintf("synchro: %s is %s in %s", e.Local, e.Kind, e.Location)
+}
+
+// Resolve returns the instant which the wall clock time represents, using
+// policy when it is skipped or repeated.
+//
+// An error is returned only if policy is DSTReject and the wall clock time
+// does not map to exactly one instant.
+func (r LocalResult[T]) Resolve(policy DSTPolicy) (Time[T], error) {
+	if r.kind == LocalSingle {
+		return r.earliest, nil
+	}
+	switch policy {
+	case DSTEarlier:
+		return r.earliest, nil
+	case DSTLater:
+		return r.latest, nil
+	case DSTReject:
+		return Time[T]{}, r.error()
+	}
+	// DSTShiftForward
+	if r.kind == LocalSkipped {
+		return r.latest, nil
+	}
+	return r.earliest, nil
+}
+
+func (r LocalResult[T]) error() error {
+	var tz T
+	return &LocalTimeError{
+		Local:    r.local,
+		Kind:     r.kind,
+		Location: tz.Location().String(),
+	}
+}
+
+// NewWithPolicy is like New, but resolves a wall clock time which is skipped
+// or repeated in the timezone T according to the given policy instead of
+// leaving the choice unspecified.
+//
+// The month, day, hour, min, sec, and nsec values may be outside
+// their usual ranges and will be normalized during the conversion.
+//
+// An error is returned only if policy is DSTReject.
+func NewWithPolicy[T TimeZone](policy DSTPolicy, year int, month time.Month, day int, hour int, min int, sec int, nsec int) (Time[T], error) {
+	return LocalIn[T](NewLocalDateTime(year, month, day, hour, min, sec, nsec)).Resolve(policy)
+}
+
+// ResolveLocal returns the instant which the wall clock time l represents in
+// the timezone T. A skipped or repeated wall clock time is resolved by
+// DSTShiftForward as RFC 5545 specifies for recurrences.
+func ResolveLocal[T TimeZone](l LocalDateTime) Time[T] {
+	t, _ := LocalIn[T](l).Resolve(DSTShiftForward)
+	return t
+}
+
+// WallClockKind reports whether the given wall clock time is skipped,
+// repeated or maps to a single instant in the timezone T.
+func WallClockKind[T TimeZone](year int, month time.Month, day int, hour int, min int, sec int, nsec int) LocalResultKind {
+	return LocalIn[T](NewLocalDateTime(year, month, day, hour, min, sec, nsec)).Kind()
+}
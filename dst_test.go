@@ -0,0 +1,154 @@
+package synchro_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/Code-Hex/synchro"
+	"github.com/Code-Hex/synchro/tz"
+)
+
+func TestNewWithPolicy(t *testing.T) {
+	type NY = tz.AmericaNew_York
+	tests := []struct {
+		name    string
+		policy  synchro.DSTPolicy
+		hour    int
+		day     int
+		month   time.Month
+		want    string
+		wantErr bool
+	}{
+		// 2023-03-12 02:30 is skipped.
+		{name: "skipped shift-forward", policy: synchro.DSTShiftForward, month: 3, day: 12, hour: 2, want: "2023-03-12T03:30:00-04:00"},
+		{name: "skipped earlier", policy: synchro.DSTEarlier, month: 3, day: 12, hour: 2, want: "2023-03-12T01:30:00-05:00"},
+		{name: "skipped later", policy: synchro.DSTLater, month: 3, day: 12, hour: 2, want: "2023-03-12T03:30:00-04:00"},
+		{name: "skipped reject", policy: synchro.DSTReject, month: 3, day: 12, hour: 2, wantErr: true},
+		// 2023-11-05 01:30 is repeated.
+		{name: "repeated shift-forward", policy: synchro.DSTShiftForward, month: 11, day: 5, hour: 1, want: "2023-11-05T01:30:00-04:00"},
+		{name: "repeated earlier", policy: synchro.DSTEarlier, month: 11, day: 5, hour: 1, want: "2023-11-05T01:30:00-04:00"},
+		{name: "repeated later", policy: synchro.DSTLater, month: 11, day: 5, hour: 1, want: "2023-11-05T01:30:00-05:00"},
+		{name: "repeated reject", policy: synchro.DSTReject, month: 11, day: 5, hour: 1, wantErr: true},
+		// ordinary wall clock time is never rejected.
+		{name: "single reject", policy: synchro.DSTReject, month: 11, day: 6, hour: 1, want: "2023-11-06T01:30:00-05:00"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := synchro.NewWithPolicy[NY](tt.policy, 2023, tt.month, tt.day, tt.hour, 30, 0, 0)
+			if tt.wantErr {
+				var lerr *synchro.LocalTimeError
+				if !errors.As(err, &lerr) {
+					t.Fatalf("want *LocalTimeError but got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := got.Format(time.RFC3339); got != tt.want {
+				t.Errorf("want %s but got %s", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestTime_ChangeWithPolicy(t *testing.T) {
+	type NY = tz.AmericaNew_York
+	base := synchro.New[NY](2023, 3, 12, 0, 30, 0, 0)
+
+	got, err := base.ChangeWithPolicy(synchro.DSTShiftForward, HH(2))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "2023-03-12T03:30:00-04:00"; got.Format(time.RFC3339) != want {
+		t.Errorf("want %s but got %s", want, got.Format(time.RFC3339))
+	}
+
+	_, err = base.ChangeWithPolicy(synchro.DSTReject, HH(2))
+	var lerr *synchro.LocalTimeError
+	if !errors.As(err, &lerr) {
+		t.Fatalf("want *LocalTimeError but got %v", err)
+	}
+	if lerr.Kind != synchro.LocalSkipped {
+		t.Errorf("want %s but got %s", synchro.LocalSkipped, lerr.Kind)
+	}
+	if want := "synchro: 2023-03-12T02:30:00 is skipped in America/New_York"; lerr.Error() != want {
+		t.Errorf("want %q but got %q", want, lerr.Error())
+	}
+}
+
+func TestTime_AdvanceWithPolicy(t *testing.T) {
+	type NY = tz.AmericaNew_York
+	// Every day at 01:30 lands on a repeated wall clock time on 2023-11-05.
+	base := synchro.New[NY](2023, 11, 4, 1, 30, 0, 0)
+
+	got, err := base.AdvanceWithPolicy(synchro.DSTLater, D(1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "2023-11-05T01:30:00-05:00"; got.Format(time.RFC3339) != want {
+		t.Errorf("want %s but got %s", want, got.Format(time.RFC3339))
+	}
+
+	_, err = base.AdvanceWithPolicy(synchro.DSTReject, D(1))
+	var lerr *synchro.LocalTimeError
+	if !errors.As(err, &lerr) {
+		t.Fatalf("want *LocalTimeError but got %v", err)
+	}
+	if lerr.Kind != synchro.LocalRepeated {
+		t.Errorf("want %s but got %s", synchro.LocalRepeated, lerr.Kind)
+	}
+}
+
+func TestWallClockKind(t *testing.T) {
+	type NY = tz.AmericaNew_York
+	if got := synchro.WallClockKind[NY](2023, 3, 12, 2, 30, 0, 0); got != synchro.LocalSkipped {
+		t.Errorf("want %s but got %s", synchro.LocalSkipped, got)
+	}
+	if got := synchro.WallClockKind[NY](2023, 11, 5, 1, 30, 0, 0); got != synchro.LocalRepeated {
+		t.Errorf("want %s but got %s", synchro.LocalRepeated, got)
+	}
+	if got := synchro.WallClockKind[tz.UTC](2023, 3, 12, 2, 30, 0, 0); got != synchro.LocalSingle {
+		t.Errorf("want %s but got %s", synchro.LocalSingle, got)
+	}
+}
+
+func TestResolveLocal(t *testing.T) {
+	type NY = tz.AmericaNew_York
+	tests := []struct {
+		local synchro.LocalDateTime
+		want  time.Time
+	}{
+		// Skipped, shifted forward by the gap.
+		{local: synchro.NewLocalDateTime(2023, 3, 12, 2, 30, 0, 0), want: time.Date(2023, 3, 12, 7, 30, 0, 0, time.UTC)},
+		// Repeated, the earlier one in EDT.
+		{local: synchro.NewLocalDateTime(2023, 11, 5, 1, 30, 0, 0), want: time.Date(2023, 11, 5, 5, 30, 0, 0, time.UTC)},
+		{local: synchro.NewLocalDateTime(2023, 9, 2, 9, 30, 0, 0), want: time.Date(2023, 9, 2, 13, 30, 0, 0, time.UTC)},
+	}
+	for _, tt := range tests {
+		got := synchro.ResolveLocal[NY](tt.local)
+		if !got.StdTime().Equal(tt.want) {
+			t.Errorf("%s: want %s but got %s", tt.local, tt.want, got)
+		}
+		if tt.local != synchro.LocalDateTimeOf(tt.local.UTC()) {
+			t.Errorf("%s: want the same wall clock but got %s", tt.local, tt.local.UTC())
+		}
+	}
+}
+
+func ExampleNewWithPolicy() {
+	type NY = tz.AmericaNew_York
+	// 2:30am never occurred in New York on 2023-03-12.
+	t1, _ := synchro.NewWithPolicy[NY](synchro.DSTShiftForward, 2023, 3, 12, 2, 30, 0, 0)
+	t2, _ := synchro.NewWithPolicy[NY](synchro.DSTEarlier, 2023, 3, 12, 2, 30, 0, 0)
+	_, err := synchro.NewWithPolicy[NY](synchro.DSTReject, 2023, 3, 12, 2, 30, 0, 0)
+	fmt.Println(t1)
+	fmt.Println(t2)
+	fmt.Println(err)
+	// Output:
+	// 2023-03-12 03:30:00 -0400 EDT
+	// 2023-03-12 01:30:00 -0500 EST
+	// synchro: 2023-03-12T02:30:00 is skipped in America/New_York
+}
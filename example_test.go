@@ -10,9 +10,7 @@ import (
 )
 
 func init() {
-	synchro.SetNow(func() time.Time {
-		return time.Date(2023, 9, 2, 14, 0, 0, 0, time.UTC)
-	})
+	synchro.SetDefaultClock(fixedClock(time.Date(2023, 9, 2, 14, 0, 0, 0, time.UTC)))
 }
 
 func ExampleIn() {
@@ -482,6 +480,23 @@ func ExamplePeriod_PeriodicISODuration() {
 	// 2009-03-01 00:00:00 +0000 UTC
 }
 
+func ExamplePeriod_PeriodicDurationSeq() {
+	p1, _ := synchro.NewPeriod[tz.UTC](
+		"2009-01-01",
+		"2009-12-31",
+	)
+	for current := range p1.PeriodicDurationSeq(time.Hour) {
+		if current.Hour() == 3 {
+			break // No goroutine is leaked.
+		}
+		fmt.Println(current)
+	}
+	// Output:
+	// 2009-01-01 00:00:00 +0000 UTC
+	// 2009-01-01 01:00:00 +0000 UTC
+	// 2009-01-01 02:00:00 +0000 UTC
+}
+
 func ExamplePeriod_periodicalSlice() {
 	p1, _ := synchro.NewPeriod[tz.UTC](
 		"2009-01-01T00:00:00",
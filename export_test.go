@@ -1,7 +0,0 @@
-package synchro
-
-import "time"
-
-func SetNow(f func() time.Time) {
-	nowFunc = f
-}
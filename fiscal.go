@@ -0,0 +1,209 @@
+package synchro
+
+import "time"
+
+// FiscalCalendar defines a fiscal year which starts on the first day of StartMonth.
+//
+// The zero value is a fiscal year identical to the calendar year.
+type FiscalCalendar struct {
+	// StartMonth is the first month of the fiscal year.
+	// Zero means January.
+	StartMonth time.Month
+
+	// NamedByEndYear reports whether the fiscal year is named after the calendar
+	// year in which it ends. By default, it is named after the year in which it starts.
+	NamedByEndYear bool
+}
+
+var (
+	// FiscalCalendarJapan is the fiscal year from April to March, named after
+	// the year in which it starts. For example, FY2023 is from April 2023 to March 2024.
+	FiscalCalendarJapan = FiscalCalendar{StartMonth: time.April}
+
+	// FiscalCalendarUSGovernment is the fiscal year from October to September, named
+	// after the year in which it ends. For example, FY2024 is from October 2023 to September 2024.
+	FiscalCalendarUSGovernment = FiscalCalendar{StartMonth: time.October, NamedByEndYear: true}
+)
+
+func (c FiscalCalendar) startMonth() time.Month {
+	if c.StartMonth < time.January || c.StartMonth > time.December {
+		return time.January
+	}
+	return c.StartMonth
+}
+
+// startYear returns the calendar year in which the fiscal year starts.
+func (c FiscalCalendar) startYear(fiscalYear int) int {
+	if c.NamedByEndYear && c.startMonth() != time.January {
+		return fiscalYear - 1
+	}
+	return fiscalYear
+}
+
+// fiscalYear returns the fiscal year and the 0-based month in the fiscal year.
+func (c FiscalCalendar) fiscalYear(year int, month time.Month) (fiscalYear, offset int) {
+	start := c.startMonth()
+	offset = (int(month) - int(start) + 12) % 12
+	if month < start {
+		year--
+	}
+	if c.NamedByEndYear && start != time.January {
+		year++
+	}
+	return year, offset
+}
+
+// FiscalYear is a fiscal year defined by FiscalCalendar.
+type FiscalYear[T TimeZone] struct {
+	cal  FiscalCalendar
+	year int
+}
+
+// FiscalYear gets the fiscal year in which t occurs.
+func (t Time[T]) FiscalYear(cal FiscalCalendar) FiscalYear[T] {
+	year, _ := cal.fiscalYear(t.Year(), t.Month())
+	return FiscalYear[T]{cal: cal, year: year}
+}
+
+// Year returns the name of the fiscal year.
+func (f FiscalYear[T]) Year() int { return f.year }
+
+// Calendar returns the FiscalCalendar of f.
+func (f FiscalYear[T]) Calendar() FiscalCalendar { return f.cal }
+
+// Start returns start time in the fiscal year.
+func (f FiscalYear[T]) Start() Time[T] {
+	return New[T](f.cal.startYear(f.year), f.cal.startMonth(), 1, 0, 0, 0, 0)
+}
+
+// End returns end time in the fiscal year.
+func (f FiscalYear[T]) End() Time[T] {
+	return New[T](f.cal.startYear(f.year), f.cal.startMonth()+12, 0, 23, 59, 59, 999999999)
+}
+
+// Next returns the next fiscal year.
+func (f FiscalYear[T]) Next() FiscalYear[T] {
+	return FiscalYear[T]{cal: f.cal, year: f.year + 1}
+}
+
+// Prev returns the previous fiscal year.
+func (f FiscalYear[T]) Prev() FiscalYear[T] {
+	return FiscalYear[T]{cal: f.cal, year: f.year - 1}
+}
+
+// Quarter returns the nth quarter of the fiscal year. n is clamped to the range 1-4.
+func (f FiscalYear[T]) Quarter(n int) FiscalQuarter[T] {
+	return FiscalQuarter[T]{cal: f.cal, year: f.year, number: min(max(n, 1), 4)}
+}
+
+// After reports whether the fiscal year f is after u.
+func (f FiscalYear[T]) After(u FiscalYear[T]) bool { return f.year > u.year }
+
+// Before reports whether the fiscal year f is before u.
+func (f FiscalYear[T]) Before(u FiscalYear[T]) bool { return f.year < u.year }
+
+// Compare compares the fiscal year f with u. If f is before u, it returns -1;
+// if f is after u, it returns +1; if they're the same, it returns 0.
+func (f FiscalYear[T]) Compare(u FiscalYear[T]) int {
+	switch {
+	case f.year < u.year:
+		return -1
+	case f.year > u.year:
+		return 1
+	}
+	return 0
+}
+
+// FiscalQuarter is a quarter of a fiscal year defined by FiscalCalendar.
+type FiscalQuarter[T TimeZone] struct {
+	cal    FiscalCalendar
+	year   int
+	number int
+}
+
+// FiscalQuarter gets the fiscal quarter in which t occurs.
+func (t Time[T]) FiscalQuarter(cal FiscalCalendar) FiscalQuarter[T] {
+	year, offset := cal.fiscalYear(t.Year(), t.Month())
+	return FiscalQuarter[T]{cal: cal, year: year, number: offset/3 + 1}
+}
+
+// Year returns the name of the fiscal year in which q occurs.
+func (q FiscalQuarter[T]) Year() int { return q.year }
+
+// Number returns the number of quarter in the fiscal year.
+func (q FiscalQuarter[T]) Number() int { return q.number }
+
+// FiscalYear returns the fiscal year in which q occurs.
+func (q FiscalQuarter[T]) FiscalYear() FiscalYear[T] {
+	return FiscalYear[T]{cal: q.cal, year: q.year}
+}
+
+// Start returns start time in the fiscal quarter.
+func (q FiscalQuarter[T]) Start() Time[T] {
+	month := q.cal.startMonth() + time.Month(3*(q.number-1))
+	return New[T](q.cal.startYear(q.year), month, 1, 0, 0, 0, 0)
+}
+
+// End returns end time in the fiscal quarter.
+func (q FiscalQuarter[T]) End() Time[T] {
+	month := q.cal.startMonth() + time.Month(3*q.number)
+	return New[T](q.cal.startYear(q.year), month, 0, 23, 59, 59, 999999999)
+}
+
+// Next returns the next fiscal quarter.
+func (q FiscalQuarter[T]) Next() FiscalQuarter[T] {
+	if q.number >= 4 {
+		return FiscalQuarter[T]{cal: q.cal, year: q.year + 1, number: 1}
+	}
+	return FiscalQuarter[T]{cal: q.cal, year: q.year, number: q.number + 1}
+}
+
+// Prev returns the previous fiscal quarter.
+func (q FiscalQuarter[T]) Prev() FiscalQuarter[T] {
+	if q.number <= 1 {
+		return FiscalQuarter[T]{cal: q.cal, year: q.year - 1, number: 4}
+	}
+	return FiscalQuarter[T]{cal: q.cal, year: q.year, number: q.number - 1}
+}
+
+// After reports whether the fiscal quarter q is after u.
+func (q FiscalQuarter[T]) After(u FiscalQuarter[T]) bool { return q.Compare(u) > 0 }
+
+// Before reports whether the fiscal quarter q is before u.
+func (q FiscalQuarter[T]) Before(u FiscalQuarter[T]) bool { return q.Compare(u) < 0 }
+
+// Compare compares the fiscal quarter q with u. If q is before u, it returns -1;
+// if q is after u, it returns +1; if they're the same, it returns 0.
+func (q FiscalQuarter[T]) Compare(u FiscalQuarter[T]) int {
+	switch {
+	case q.year < u.year:
+		return -1
+	case q.year > u.year:
+		return 1
+	case q.number < u.number:
+		return -1
+	case q.number > u.number:
+		return 1
+	}
+	return 0
+}
+
+// StartOfFiscalYear returns a Time for start of the fiscal year.
+func (t Time[T]) StartOfFiscalYear(cal FiscalCalendar) Time[T] {
+	return t.FiscalYear(cal).Start()
+}
+
+// EndOfFiscalYear returns a Time for end of the fiscal year.
+func (t Time[T]) EndOfFiscalYear(cal FiscalCalendar) Time[T] {
+	return t.FiscalYear(cal).End()
+}
+
+// StartOfFiscalQuarter returns a Time for start of the fiscal quarter.
+func (t Time[T]) StartOfFiscalQuarter(cal FiscalCalendar) Time[T] {
+	return t.FiscalQuarter(cal).Start()
+}
+
+// EndOfFiscalQuarter returns a Time for end of the fiscal quarter.
+func (t Time[T]) EndOfFiscalQuarter(cal FiscalCalendar) Time[T] {
+	return t.FiscalQuarter(cal).End()
+}
@@ -0,0 +1,164 @@
+package synchro_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/Code-Hex/synchro"
+	"github.com/Code-Hex/synchro/tz"
+)
+
+func TestFiscalQuarter(t *testing.T) {
+	tests := []struct {
+		name       string
+		cal        synchro.FiscalCalendar
+		date       synchro.Time[tz.UTC]
+		wantYear   int
+		wantNumber int
+		wantStart  synchro.Time[tz.UTC]
+		wantEnd    synchro.Time[tz.UTC]
+	}{
+		{
+			name:       "calendar year",
+			cal:        synchro.FiscalCalendar{},
+			date:       synchro.New[tz.UTC](2023, 5, 15, 0, 0, 0, 0),
+			wantYear:   2023,
+			wantNumber: 2,
+			wantStart:  synchro.New[tz.UTC](2023, 4, 1, 0, 0, 0, 0),
+			wantEnd:    synchro.New[tz.UTC](2023, 6, 30, 23, 59, 59, 999999999),
+		},
+		{
+			name:       "Japan April",
+			cal:        synchro.FiscalCalendarJapan,
+			date:       synchro.New[tz.UTC](2023, 4, 1, 0, 0, 0, 0),
+			wantYear:   2023,
+			wantNumber: 1,
+			wantStart:  synchro.New[tz.UTC](2023, 4, 1, 0, 0, 0, 0),
+			wantEnd:    synchro.New[tz.UTC](2023, 6, 30, 23, 59, 59, 999999999),
+		},
+		{
+			name:       "Japan March",
+			cal:        synchro.FiscalCalendarJapan,
+			date:       synchro.New[tz.UTC](2024, 3, 31, 0, 0, 0, 0),
+			wantYear:   2023,
+			wantNumber: 4,
+			wantStart:  synchro.New[tz.UTC](2024, 1, 1, 0, 0, 0, 0),
+			wantEnd:    synchro.New[tz.UTC](2024, 3, 31, 23, 59, 59, 999999999),
+		},
+		{
+			name:       "US government October",
+			cal:        synchro.FiscalCalendarUSGovernment,
+			date:       synchro.New[tz.UTC](2023, 10, 1, 0, 0, 0, 0),
+			wantYear:   2024,
+			wantNumber: 1,
+			wantStart:  synchro.New[tz.UTC](2023, 10, 1, 0, 0, 0, 0),
+			wantEnd:    synchro.New[tz.UTC](2023, 12, 31, 23, 59, 59, 999999999),
+		},
+		{
+			name:       "US government September",
+			cal:        synchro.FiscalCalendarUSGovernment,
+			date:       synchro.New[tz.UTC](2024, 9, 30, 0, 0, 0, 0),
+			wantYear:   2024,
+			wantNumber: 4,
+			wantStart:  synchro.New[tz.UTC](2024, 7, 1, 0, 0, 0, 0),
+			wantEnd:    synchro.New[tz.UTC](2024, 9, 30, 23, 59, 59, 999999999),
+		},
+		{
+			name:       "February start",
+			cal:        synchro.FiscalCalendar{StartMonth: time.February},
+			date:       synchro.New[tz.UTC](2024, 1, 31, 0, 0, 0, 0),
+			wantYear:   2023,
+			wantNumber: 4,
+			wantStart:  synchro.New[tz.UTC](2023, 11, 1, 0, 0, 0, 0),
+			wantEnd:    synchro.New[tz.UTC](2024, 1, 31, 23, 59, 59, 999999999),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			q := tt.date.FiscalQuarter(tt.cal)
+			if q.Year() != tt.wantYear {
+				t.Errorf("want year %d but got %d", tt.wantYear, q.Year())
+			}
+			if q.Number() != tt.wantNumber {
+				t.Errorf("want number %d but got %d", tt.wantNumber, q.Number())
+			}
+			if !tt.wantStart.Equal(q.Start()) {
+				t.Errorf("want start %s but got %s", tt.wantStart, q.Start())
+			}
+			if !tt.wantEnd.Equal(q.End()) {
+				t.Errorf("want end %s but got %s", tt.wantEnd, q.End())
+			}
+			if got := tt.date.StartOfFiscalQuarter(tt.cal); !tt.wantStart.Equal(got) {
+				t.Errorf("want StartOfFiscalQuarter %s but got %s", tt.wantStart, got)
+			}
+			if got := tt.date.EndOfFiscalQuarter(tt.cal); !tt.wantEnd.Equal(got) {
+				t.Errorf("want EndOfFiscalQuarter %s but got %s", tt.wantEnd, got)
+			}
+			if got := q.Next().Start(); !q.End().Add(1).Equal(got) {
+				t.Errorf("want next start %s but got %s", q.End().Add(1), got)
+			}
+			if got := q.Prev().End(); !q.Start().Add(-1).Equal(got) {
+				t.Errorf("want prev end %s but got %s", q.Start().Add(-1), got)
+			}
+			if !q.Next().After(q) || !q.Prev().Before(q) || q.Compare(q) != 0 {
+				t.Error("want Next after q and Prev before q")
+			}
+		})
+	}
+}
+
+func TestFiscalYear(t *testing.T) {
+	d := synchro.New[tz.AsiaTokyo](2024, 2, 10, 12, 0, 0, 0)
+	fy := d.FiscalYear(synchro.FiscalCalendarJapan)
+	if fy.Year() != 2023 {
+		t.Errorf("want 2023 but got %d", fy.Year())
+	}
+	if want := synchro.New[tz.AsiaTokyo](2023, 4, 1, 0, 0, 0, 0); !want.Equal(fy.Start()) {
+		t.Errorf("want start %s but got %s", want, fy.Start())
+	}
+	if want := synchro.New[tz.AsiaTokyo](2024, 3, 31, 23, 59, 59, 999999999); !want.Equal(fy.End()) {
+		t.Errorf("want end %s but got %s", want, fy.End())
+	}
+	if !fy.Start().Equal(d.StartOfFiscalYear(synchro.FiscalCalendarJapan)) || !fy.End().Equal(d.EndOfFiscalYear(synchro.FiscalCalendarJapan)) {
+		t.Error("want StartOfFiscalYear and EndOfFiscalYear to match the fiscal year")
+	}
+	if got := fy.Next().Start(); !fy.End().Add(1).Equal(got) {
+		t.Errorf("want next start %s but got %s", fy.End().Add(1), got)
+	}
+	if got := fy.Prev().Year(); got != 2022 {
+		t.Errorf("want 2022 but got %d", got)
+	}
+	if got := fy.Quarter(4).Start(); !synchro.New[tz.AsiaTokyo](2024, 1, 1, 0, 0, 0, 0).Equal(got) {
+		t.Errorf("want 2024-01-01 but got %s", got)
+	}
+	if fy.Quarter(2).FiscalYear() != fy {
+		t.Error("want the same fiscal year")
+	}
+	// n is clamped to the fiscal year.
+	for _, n := range []int{0, -1} {
+		if got := fy.Quarter(n); got != fy.Quarter(1) || !got.Start().Equal(fy.Start()) {
+			t.Errorf("Quarter(%d): want Q1 from %s but got Q%d from %s", n, fy.Start(), got.Number(), got.Start())
+		}
+	}
+	for _, n := range []int{5, 100} {
+		if got := fy.Quarter(n); got != fy.Quarter(4) || !got.End().Equal(fy.End()) {
+			t.Errorf("Quarter(%d): want Q4 until %s but got Q%d until %s", n, fy.End(), got.Number(), got.End())
+		}
+	}
+	if fy.Compare(fy.Next()) != -1 || !fy.Next().After(fy) || !fy.Before(fy.Next()) {
+		t.Error("want fy before the next fiscal year")
+	}
+}
+
+func ExampleTime_FiscalQuarter() {
+	d := synchro.New[tz.UTC](2023, 11, 15, 0, 0, 0, 0)
+	q := d.FiscalQuarter(synchro.FiscalCalendarUSGovernment)
+	fmt.Printf("FY%d Q%d\n", q.Year(), q.Number())
+	fmt.Println(q.Start())
+	fmt.Println(q.End())
+	// Output:
+	// FY2024 Q1
+	// 2023-10-01 00:00:00 +0000 UTC
+	// 2023-12-31 23:59:59.999999999 +0000 UTC
+}
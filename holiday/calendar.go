@@ -0,0 +1,245 @@
+// Package holiday provides rule-based holiday calendars which can be used
+// as synchro.Calendar for business day calculations.
+package holiday
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/Code-Hex/synchro/iso8601"
+)
+
+// Substitution determines the date on which a holiday is observed
+// when it falls on a weekend.
+type Substitution int
+
+const (
+	// NoSubstitution means the holiday is not observed on another day.
+	NoSubstitution Substitution = iota
+
+	// NextDayIfSunday means a holiday falling on Sunday is observed on the
+	// next day which is not a holiday. This is the substitute holiday in Japan.
+	NextDayIfSunday
+
+	// NearestWeekday means a holiday falling on Saturday is observed on the
+	// preceding Friday, and one falling on Sunday is observed on the following
+	// Monday. This is the rule of federal holidays in the United States.
+	NearestWeekday
+
+	// NextWeekday means a holiday falling on a weekend is observed on the
+	// next day which is neither a weekend nor a holiday, usually Monday.
+	NextWeekday
+)
+
+// Holiday is a named holiday rule.
+type Holiday struct {
+	Name         string
+	Rule         Rule
+	Substitution Substitution
+}
+
+// Entry is a holiday on a specific date.
+type Entry struct {
+	Date iso8601.Date
+	Name string
+}
+
+// Calendar is a rule-based holiday calendar.
+// It implements the synchro.Calendar interface.
+//
+// Holidays are computed lazily for each year and cached,
+// so a Calendar is safe for concurrent use.
+type Calendar struct {
+	holidays   []Holiday
+	weekend    [7]bool
+	bridgeName string
+
+	mu    sync.Mutex
+	cache map[int]map[iso8601.Date]string
+}
+
+// Option is a function type that modifies the behavior of Calendar.
+// It acts as a functional option.
+type Option func(*Calendar)
+
+// WithWeekend is an option to set the weekend days of the calendar.
+//
+// By default, Saturday and Sunday are the weekend.
+func WithWeekend(days ...time.Weekday) Option {
+	return func(c *Calendar) {
+		c.weekend = [7]bool{}
+		for _, d := range days {
+			c.weekend[d] = true
+		}
+	}
+}
+
+// WithBridge is an option to make a day which is sandwiched between two
+// holidays a holiday named name, such as the "Citizens' Holiday" in Japan.
+func WithBridge(name string) Option {
+	return func(c *Calendar) {
+		c.bridgeName = name
+	}
+}
+
+// New returns a new Calendar for the given holidays.
+func New(holidays []Holiday, opts ...Option) *Calendar {
+	c := &Calendar{
+		holidays: holidays,
+		cache:    make(map[int]map[iso8601.Date]string),
+	}
+	c.weekend[time.Saturday] = true
+	c.weekend[time.Sunday] = true
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// IsWeekend reports whether the weekday is a weekend.
+func (c *Calendar) IsWeekend(weekday time.Weekday) bool {
+	return c.weekend[weekday]
+}
+
+// IsHoliday reports whether the date is a holiday, including the
+// dates on which holidays are observed.
+func (c *Calendar) IsHoliday(date iso8601.Date) bool {
+	_, ok := c.HolidayName(date)
+	return ok
+}
+
+// HolidayName returns the name of the holiday on the date.
+// If the date is not a holiday, ok is false.
+func (c *Calendar) HolidayName(date iso8601.Date) (name string, ok bool) {
+	name, ok = c.year(date.Year)[date]
+	return name, ok
+}
+
+// Holidays returns the holidays in the year sorted by date.
+func (c *Calendar) Holidays(year int) []Entry {
+	m := c.year(year)
+	entries := make([]Entry, 0, len(m))
+	for d, name := range m {
+		entries = append(entries, Entry{Date: d, Name: name})
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].Date.Before(entries[j].Date)
+	})
+	return entries
+}
+
+func (c *Calendar) year(year int) map[iso8601.Date]string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if m, ok := c.cache[year]; ok {
+		return m
+	}
+	m := c.compute(year)
+	c.cache[year] = m
+	return m
+}
+
+type observance struct {
+	date         iso8601.Date
+	name         string
+	substitution Substitution
+}
+
+func (c *Calendar) compute(year int) map[iso8601.Date]string {
+	// A holiday may be observed in the adjacent year, e.g. January 1 falling on
+	// Saturday is observed on December 31 of the previous year.
+	all := make(map[iso8601.Date]string)
+	var substitutes []observance
+	for y := year - 1; y <= year+1; y++ {
+		for _, h := range c.holidays {
+			d, ok := h.Rule.Date(y)
+			if !ok {
+				continue
+			}
+			if _, exists := all[d]; !exists {
+				all[d] = h.Name
+			}
+			if h.Substitution != NoSubstitution {
+				substitutes = append(substitutes, observance{
+					date:         d,
+					name:         h.Name,
+					substitution: h.Substitution,
+				})
+			}
+		}
+	}
+
+	if c.bridgeName != "" {
+		var bridges []iso8601.Date
+		for d := range all {
+			next := d.AddDays(1)
+			if _, ok := all[next]; ok {
+				continue
+			}
+			if _, ok := all[next.AddDays(1)]; ok {
+				bridges = append(bridges, next)
+			}
+		}
+		for _, d := range bridges {
+			all[d] = c.bridgeName
+		}
+	}
+
+	sort.Slice(substitutes, func(i, j int) bool {
+		return substitutes[i].date.Before(substitutes[j].date)
+	})
+	for _, s := range substitutes {
+		if d, ok := c.observed(all, s); ok {
+			all[d] = s.name + " (observed)"
+		}
+	}
+
+	m := make(map[iso8601.Date]string)
+	for d, name := range all {
+		if d.Year == year {
+			m[d] = name
+		}
+	}
+	return m
+}
+
+func (c *Calendar) observed(holidays map[iso8601.Date]string, s observance) (iso8601.Date, bool) {
+	isHoliday := func(d iso8601.Date) bool {
+		_, ok := holidays[d]
+		return ok
+	}
+	weekday := s.date.StdTime().Weekday()
+	switch s.substitution {
+	case NextDayIfSunday:
+		if weekday != time.Sunday {
+			return iso8601.Date{}, false
+		}
+		d := s.date.AddDays(1)
+		for isHoliday(d) {
+			d = d.AddDays(1)
+		}
+		return d, true
+	case NearestWeekday:
+		var d iso8601.Date
+		switch weekday {
+		case time.Saturday:
+			d = s.date.AddDays(-1)
+		case time.Sunday:
+			d = s.date.AddDays(1)
+		default:
+			return iso8601.Date{}, false
+		}
+		return d, !isHoliday(d)
+	case NextWeekday:
+		if !c.weekend[weekday] {
+			return iso8601.Date{}, false
+		}
+		d := s.date.AddDays(1)
+		for c.weekend[d.StdTime().Weekday()] || isHoliday(d) {
+			d = d.AddDays(1)
+		}
+		return d, true
+	}
+	return iso8601.Date{}, false
+}
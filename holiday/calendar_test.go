@@ -0,0 +1,216 @@
+package holiday
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/Code-Hex/synchro/iso8601"
+	"github.com/google/go-cmp/cmp"
+)
+
+func dates(t *testing.T, entries []Entry) []string {
+	t.Helper()
+	s := make([]string, 0, len(entries))
+	for _, e := range entries {
+		s = append(s, e.Date.String())
+	}
+	return s
+}
+
+func TestJapan(t *testing.T) {
+	tests := []struct {
+		year int
+		want []string
+	}{
+		{
+			year: 2019,
+			want: []string{
+				"2019-01-01", "2019-01-14", "2019-02-11", "2019-03-21",
+				"2019-04-29", "2019-04-30", "2019-05-01", "2019-05-02",
+				"2019-05-03", "2019-05-04", "2019-05-05", "2019-05-06",
+				"2019-07-15", "2019-08-11", "2019-08-12", "2019-09-16",
+				"2019-09-23", "2019-10-14", "2019-10-22", "2019-11-03",
+				"2019-11-04", "2019-11-23",
+			},
+		},
+		{
+			year: 2020,
+			want: []string{
+				"2020-01-01", "2020-01-13", "2020-02-11", "2020-02-23",
+				"2020-02-24", "2020-03-20", "2020-04-29", "2020-05-03",
+				"2020-05-04", "2020-05-05", "2020-05-06", "2020-07-23",
+				"2020-07-24", "2020-08-10", "2020-09-21", "2020-09-22",
+				"2020-11-03", "2020-11-23",
+			},
+		},
+		{
+			year: 2026,
+			want: []string{
+				"2026-01-01", "2026-01-12", "2026-02-11", "2026-02-23",
+				"2026-03-20", "2026-04-29", "2026-05-03", "2026-05-04",
+				"2026-05-05", "2026-05-06", "2026-07-20", "2026-08-11",
+				"2026-09-21", "2026-09-22", "2026-09-23", "2026-10-12",
+				"2026-11-03", "2026-11-23",
+			},
+		},
+	}
+	cal := Japan()
+	for _, tt := range tests {
+		t.Run(strconv.Itoa(tt.year), func(t *testing.T) {
+			got := dates(t, cal.Holidays(tt.year))
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("(-want, +got)\n%s", diff)
+			}
+		})
+	}
+
+	name, ok := cal.HolidayName(iso8601.Date{Year: 2026, Month: time.September, Day: 22})
+	if !ok || name != "Citizens' Holiday" {
+		t.Errorf("want Citizens' Holiday but got %q (%v)", name, ok)
+	}
+	name, ok = cal.HolidayName(iso8601.Date{Year: 2020, Month: time.May, Day: 6})
+	if !ok || name != "Constitution Memorial Day (observed)" {
+		t.Errorf("want substitute holiday but got %q (%v)", name, ok)
+	}
+}
+
+func TestUS(t *testing.T) {
+	tests := []struct {
+		year int
+		want []string
+	}{
+		{
+			year: 2021,
+			want: []string{
+				"2021-01-01", "2021-01-18", "2021-02-15", "2021-05-31",
+				"2021-06-18", "2021-06-19", "2021-07-04", "2021-07-05",
+				"2021-09-06", "2021-10-11", "2021-11-11", "2021-11-25",
+				"2021-12-24", "2021-12-25",
+				// New Year's Day 2022 falls on Saturday.
+				"2021-12-31",
+			},
+		},
+		{
+			year: 2022,
+			want: []string{
+				"2022-01-01", "2022-01-17", "2022-02-21", "2022-05-30",
+				"2022-06-19", "2022-06-20", "2022-07-04", "2022-09-05",
+				"2022-10-10", "2022-11-11", "2022-11-24", "2022-12-25",
+				"2022-12-26",
+			},
+		},
+	}
+	cal := US()
+	for _, tt := range tests {
+		t.Run(strconv.Itoa(tt.year), func(t *testing.T) {
+			got := dates(t, cal.Holidays(tt.year))
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("(-want, +got)\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGermany(t *testing.T) {
+	want := []string{
+		"2024-01-01", "2024-03-29", "2024-04-01", "2024-05-01",
+		"2024-05-09", "2024-05-20", "2024-10-03", "2024-12-25",
+		"2024-12-26",
+	}
+	got := dates(t, Germany().Holidays(2024))
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("(-want, +got)\n%s", diff)
+	}
+}
+
+func TestNthWeekday(t *testing.T) {
+	tests := []struct {
+		name    string
+		rule    Rule
+		want    iso8601.Date
+		wantOK  bool
+		forYear int
+	}{
+		{
+			name:    "first Monday",
+			rule:    NthWeekday(time.September, time.Monday, 1),
+			want:    iso8601.Date{Year: 2023, Month: time.September, Day: 4},
+			wantOK:  true,
+			forYear: 2023,
+		},
+		{
+			name:    "last Monday",
+			rule:    NthWeekday(time.May, time.Monday, -1),
+			want:    iso8601.Date{Year: 2023, Month: time.May, Day: 29},
+			wantOK:  true,
+			forYear: 2023,
+		},
+		{
+			name:    "second last Friday",
+			rule:    NthWeekday(time.March, time.Friday, -2),
+			want:    iso8601.Date{Year: 2023, Month: time.March, Day: 24},
+			wantOK:  true,
+			forYear: 2023,
+		},
+		{
+			name:    "fifth Monday does not exist",
+			rule:    NthWeekday(time.February, time.Monday, 5),
+			forYear: 2023,
+		},
+		{
+			name:    "zero",
+			rule:    NthWeekday(time.February, time.Monday, 0),
+			forYear: 2023,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := tt.rule.Date(tt.forYear)
+			if ok != tt.wantOK {
+				t.Fatalf("want ok %v but got %v", tt.wantOK, ok)
+			}
+			if got != tt.want {
+				t.Errorf("want %s but got %s", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestEaster(t *testing.T) {
+	want := map[int]string{
+		2000: "2000-04-23",
+		2019: "2019-04-21",
+		2024: "2024-03-31",
+		2025: "2025-04-20",
+		2038: "2038-04-25",
+	}
+	for year, want := range want {
+		got, _ := Easter(0).Date(year)
+		if got.String() != want {
+			t.Errorf("want %s but got %s", want, got)
+		}
+	}
+}
+
+func TestWithWeekend(t *testing.T) {
+	cal := New(nil, WithWeekend(time.Friday, time.Saturday))
+	for wd := time.Sunday; wd <= time.Saturday; wd++ {
+		want := wd == time.Friday || wd == time.Saturday
+		if got := cal.IsWeekend(wd); got != want {
+			t.Errorf("%s: want %v but got %v", wd, want, got)
+		}
+	}
+}
+
+func TestNextWeekday(t *testing.T) {
+	// Christmas Day and Boxing Day in England and Wales.
+	cal := New([]Holiday{
+		{Name: "Christmas Day", Rule: Fixed(time.December, 25), Substitution: NextWeekday},
+		{Name: "Boxing Day", Rule: Fixed(time.December, 26), Substitution: NextWeekday},
+	})
+	want := []string{"2021-12-25", "2021-12-26", "2021-12-27", "2021-12-28"}
+	if diff := cmp.Diff(want, dates(t, cal.Holidays(2021))); diff != "" {
+		t.Errorf("(-want, +got)\n%s", diff)
+	}
+}
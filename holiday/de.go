@@ -0,0 +1,21 @@
+package holiday
+
+import "time"
+
+// Germany returns a Calendar of the nationwide public holidays in Germany.
+// Holidays which are only observed in some states are not included.
+func Germany() *Calendar {
+	return New(germanyHolidays)
+}
+
+var germanyHolidays = []Holiday{
+	{Name: "Neujahr", Rule: Fixed(time.January, 1)},
+	{Name: "Karfreitag", Rule: Easter(-2)},
+	{Name: "Ostermontag", Rule: Easter(1)},
+	{Name: "Tag der Arbeit", Rule: Fixed(time.May, 1)},
+	{Name: "Christi Himmelfahrt", Rule: Easter(39)},
+	{Name: "Pfingstmontag", Rule: Easter(50)},
+	{Name: "Tag der Deutschen Einheit", Rule: Years(1990, 0, Fixed(time.October, 3))},
+	{Name: "1. Weihnachtstag", Rule: Fixed(time.December, 25)},
+	{Name: "2. Weihnachtstag", Rule: Fixed(time.December, 26)},
+}
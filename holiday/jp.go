@@ -0,0 +1,78 @@
+package holiday
+
+import (
+	"time"
+
+	"github.com/Code-Hex/synchro/iso8601"
+)
+
+// Japan returns a Calendar of the national holidays in Japan
+// ("国民の祝日に関する法律"), including substitute holidays and
+// citizens' holidays.
+//
+// The rules reproduce the national holidays since 2000. The vernal and
+// autumnal equinox days are computed by the approximation formula which
+// is valid from 1980 to 2099.
+func Japan() *Calendar {
+	return New(japanHolidays, WithBridge("Citizens' Holiday"))
+}
+
+var japanHolidays = []Holiday{
+	{Name: "New Year's Day", Rule: Fixed(time.January, 1), Substitution: NextDayIfSunday},
+	{Name: "Coming of Age Day", Rule: NthWeekday(time.January, time.Monday, 2)},
+	{Name: "National Foundation Day", Rule: Fixed(time.February, 11), Substitution: NextDayIfSunday},
+	{Name: "Emperor's Birthday", Rule: Years(0, 2018, Fixed(time.December, 23)), Substitution: NextDayIfSunday},
+	{Name: "Emperor's Birthday", Rule: Years(2020, 0, Fixed(time.February, 23)), Substitution: NextDayIfSunday},
+	{Name: "Vernal Equinox Day", Rule: RuleFunc(vernalEquinoxDay), Substitution: NextDayIfSunday},
+	{Name: "Greenery Day", Rule: Years(0, 2006, Fixed(time.April, 29)), Substitution: NextDayIfSunday},
+	{Name: "Showa Day", Rule: Years(2007, 0, Fixed(time.April, 29)), Substitution: NextDayIfSunday},
+	{Name: "Constitution Memorial Day", Rule: Fixed(time.May, 3), Substitution: NextDayIfSunday},
+	{Name: "Greenery Day", Rule: Years(2007, 0, Fixed(time.May, 4)), Substitution: NextDayIfSunday},
+	{Name: "Children's Day", Rule: Fixed(time.May, 5), Substitution: NextDayIfSunday},
+	{Name: "Marine Day", Rule: Years(0, 2002, Fixed(time.July, 20)), Substitution: NextDayIfSunday},
+	{Name: "Marine Day", Rule: exceptYears(Years(2003, 0, NthWeekday(time.July, time.Monday, 3)), 2020, 2021)},
+	{Name: "Marine Day", Rule: Years(2020, 2020, Fixed(time.July, 23))},
+	{Name: "Marine Day", Rule: Years(2021, 2021, Fixed(time.July, 22))},
+	{Name: "Mountain Day", Rule: exceptYears(Years(2016, 0, Fixed(time.August, 11)), 2020, 2021), Substitution: NextDayIfSunday},
+	{Name: "Mountain Day", Rule: Years(2020, 2020, Fixed(time.August, 10))},
+	{Name: "Mountain Day", Rule: Years(2021, 2021, Fixed(time.August, 8)), Substitution: NextDayIfSunday},
+	{Name: "Respect for the Aged Day", Rule: Years(0, 2002, Fixed(time.September, 15)), Substitution: NextDayIfSunday},
+	{Name: "Respect for the Aged Day", Rule: Years(2003, 0, NthWeekday(time.September, time.Monday, 3))},
+	{Name: "Autumnal Equinox Day", Rule: RuleFunc(autumnalEquinoxDay), Substitution: NextDayIfSunday},
+	{Name: "Health and Sports Day", Rule: Years(0, 2019, NthWeekday(time.October, time.Monday, 2))},
+	{Name: "Sports Day", Rule: exceptYears(Years(2020, 0, NthWeekday(time.October, time.Monday, 2)), 2020, 2021)},
+	{Name: "Sports Day", Rule: Years(2020, 2020, Fixed(time.July, 24))},
+	{Name: "Sports Day", Rule: Years(2021, 2021, Fixed(time.July, 23))},
+	{Name: "Culture Day", Rule: Fixed(time.November, 3), Substitution: NextDayIfSunday},
+	{Name: "Labor Thanksgiving Day", Rule: Fixed(time.November, 23), Substitution: NextDayIfSunday},
+	{Name: "Enthronement Day", Rule: Years(2019, 2019, Fixed(time.May, 1))},
+	{Name: "Enthronement Ceremony Day", Rule: Years(2019, 2019, Fixed(time.October, 22))},
+}
+
+func exceptYears(rule Rule, years ...int) Rule {
+	return RuleFunc(func(year int) (iso8601.Date, bool) {
+		for _, y := range years {
+			if y == year {
+				return iso8601.Date{}, false
+			}
+		}
+		return rule.Date(year)
+	})
+}
+
+func vernalEquinoxDay(year int) (iso8601.Date, bool) {
+	return equinoxDay(year, time.March, 20.8431)
+}
+
+func autumnalEquinoxDay(year int) (iso8601.Date, bool) {
+	return equinoxDay(year, time.September, 23.2488)
+}
+
+func equinoxDay(year int, month time.Month, base float64) (iso8601.Date, bool) {
+	if year < 1980 || year > 2099 {
+		return iso8601.Date{}, false
+	}
+	y := year - 1980
+	day := int(base+0.242194*float64(y)) - y/4
+	return iso8601.Date{Year: year, Month: month, Day: day}, true
+}
@@ -0,0 +1,95 @@
+package holiday
+
+import (
+	"time"
+
+	"github.com/Code-Hex/synchro/iso8601"
+)
+
+// Rule determines the date of a holiday in a year.
+type Rule interface {
+	// Date returns the date of the holiday in the given year.
+	// ok is false if the holiday is not held in the year.
+	Date(year int) (date iso8601.Date, ok bool)
+}
+
+// RuleFunc is an adapter to allow the use of ordinary functions as Rule.
+type RuleFunc func(year int) (iso8601.Date, bool)
+
+// Date calls f(year).
+func (f RuleFunc) Date(year int) (iso8601.Date, bool) { return f(year) }
+
+// Fixed returns a Rule for a holiday held on the same month and day every year.
+// For example, Fixed(time.December, 25) is Christmas Day.
+func Fixed(month time.Month, day int) Rule {
+	return RuleFunc(func(year int) (iso8601.Date, bool) {
+		d := iso8601.Date{Year: year, Month: month, Day: day}
+		return d, d.IsValid()
+	})
+}
+
+// NthWeekday returns a Rule for a holiday held on the n-th weekday of the month.
+// If n is negative, it counts from the end of the month. For example,
+// NthWeekday(time.November, time.Thursday, 4) is the fourth Thursday of November
+// and NthWeekday(time.May, time.Monday, -1) is the last Monday of May.
+func NthWeekday(month time.Month, weekday time.Weekday, n int) Rule {
+	return RuleFunc(func(year int) (iso8601.Date, bool) {
+		if n == 0 {
+			return iso8601.Date{}, false
+		}
+		var t time.Time
+		if n > 0 {
+			first := time.Date(year, month, 1, 0, 0, 0, 0, time.UTC)
+			offset := (int(weekday) - int(first.Weekday()) + 7) % 7
+			t = first.AddDate(0, 0, offset+(n-1)*7)
+		} else {
+			last := time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC)
+			offset := (int(last.Weekday()) - int(weekday) + 7) % 7
+			t = last.AddDate(0, 0, -offset+(n+1)*7)
+		}
+		if t.Month() != month {
+			return iso8601.Date{}, false
+		}
+		return iso8601.DateOf(t), true
+	})
+}
+
+// Easter returns a Rule for a holiday held offset days after Easter Sunday
+// in the Gregorian calendar. For example, Easter(-2) is Good Friday and
+// Easter(1) is Easter Monday.
+func Easter(offset int) Rule {
+	return RuleFunc(func(year int) (iso8601.Date, bool) {
+		return easterSunday(year).AddDays(offset), true
+	})
+}
+
+// Years restricts rule to the years from from to to inclusive.
+// If to is zero, the holiday is held every year since from.
+func Years(from, to int, rule Rule) Rule {
+	return RuleFunc(func(year int) (iso8601.Date, bool) {
+		if year < from || (to != 0 && year > to) {
+			return iso8601.Date{}, false
+		}
+		return rule.Date(year)
+	})
+}
+
+// easterSunday computes the date of Easter Sunday using the anonymous
+// Gregorian algorithm (Meeus/Jones/Butcher).
+func easterSunday(year int) iso8601.Date {
+	a := year % 19
+	b := year / 100
+	c := year % 100
+	d := b / 4
+	e := b % 4
+	f := (b + 8) / 25
+	g := (b - f + 1) / 3
+	h := (19*a + b - d - g + 15) % 30
+	i := c / 4
+	k := c % 4
+	l := (32 + 2*e + 2*i - h - k) % 7
+	m := (a + 11*h + 22*l) / 451
+	month := (h + l - 7*m + 114) / 31
+	day := (h+l-7*m+114)%31 + 1
+	return iso8601.Date{Year: year, Month: time.Month(month), Day: day}
+}
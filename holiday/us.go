@@ -0,0 +1,24 @@
+package holiday
+
+import "time"
+
+// US returns a Calendar of the federal holidays in the United States
+// (5 U.S.C. 6103). A holiday falling on Saturday is observed on the
+// preceding Friday, and one falling on Sunday on the following Monday.
+func US() *Calendar {
+	return New(usHolidays)
+}
+
+var usHolidays = []Holiday{
+	{Name: "New Year's Day", Rule: Fixed(time.January, 1), Substitution: NearestWeekday},
+	{Name: "Birthday of Martin Luther King, Jr.", Rule: Years(1986, 0, NthWeekday(time.January, time.Monday, 3))},
+	{Name: "Washington's Birthday", Rule: NthWeekday(time.February, time.Monday, 3)},
+	{Name: "Memorial Day", Rule: NthWeekday(time.May, time.Monday, -1)},
+	{Name: "Juneteenth National Independence Day", Rule: Years(2021, 0, Fixed(time.June, 19)), Substitution: NearestWeekday},
+	{Name: "Independence Day", Rule: Fixed(time.July, 4), Substitution: NearestWeekday},
+	{Name: "Labor Day", Rule: NthWeekday(time.September, time.Monday, 1)},
+	{Name: "Columbus Day", Rule: NthWeekday(time.October, time.Monday, 2)},
+	{Name: "Veterans Day", Rule: Fixed(time.November, 11), Substitution: NearestWeekday},
+	{Name: "Thanksgiving Day", Rule: NthWeekday(time.November, time.Thursday, 4)},
+	{Name: "Christmas Day", Rule: Fixed(time.December, 25), Substitution: NearestWeekday},
+}
@@ -680,6 +680,121 @@ func NewDuration(d time.Duration) Duration {
 	}
 }
 
+// AddTo returns the time t+d. Unlike StdDuration, it is exact: years and
+// months are added by the calendar, so that P1M added to January 15 is
+// February 15, then weeks and days are added, and then the clock components
+// are added. If the resulting month does not have the day of t, the day is
+// clamped to the last day of the month, e.g. P1M added to January 31 is
+// February 28 (or 29 in a leap year).
+func (d Duration) AddTo(t time.Time) time.Time {
+	return addDuration(t, d, 1)
+}
+
+// SubFrom returns the time t-d. It is the inverse of AddTo: the components are
+// subtracted in the reverse order, the clock first, then weeks and days, and
+// then years and months. So d.SubFrom(d.AddTo(t)) is t unless the day of t is
+// clamped by AddTo or the time falls in a gap of the daylight saving time.
+func (d Duration) SubFrom(t time.Time) time.Time {
+	return addDuration(t, d, -1)
+}
+
+// addDuration adds d n times to t. If n is positive, years and months are added
+// first as addDate, then weeks and days, and then the clock components. If n is
+// negative, they are subtracted in the reverse order, so that it is the inverse.
+func addDuration(t time.Time, d Duration, n int) time.Time {
+	sign := n
+	if d.Negative {
+		sign = -sign
+	}
+	months := sign * (12*d.Year + int(d.Month))
+	days := sign * (7*d.Week + d.Day)
+	clock := time.Duration(n) * d.StdClockDuration()
+	if n < 0 {
+		t = t.Add(clock)
+		t = addDate(t, 0, days)
+		return addDate(t, months, 0)
+	}
+	return addDate(t, months, days).Add(clock)
+}
+
+// addDate adds months and then days to the date of t, keeping the wall clock.
+// Unlike time.Time.AddDate, if the month does not have the day of t after
+// adding months, the day is clamped to the last day of the month.
+func addDate(t time.Time, months, days int) time.Time {
+	if months == 0 && days == 0 {
+		return t
+	}
+	year, month, day := t.Date()
+	hour, minute, sec := t.Clock()
+	first := time.Date(year, month+time.Month(months), 1, 0, 0, 0, 0, time.UTC)
+	last := first.AddDate(0, 1, -1).Day()
+	return time.Date(first.Year(), first.Month(), min(day, last)+days, hour, minute, sec, t.Nanosecond(), t.Location())
+}
+
+// CompareAt compares d and u as the lengths from ref, which are calculated by
+// AddTo. It returns -1 if d is shorter than u, +1 if d is longer, and 0 if they
+// are the same. For example, P1M is longer than P30D from January 1, but
+// shorter from February 1.
+func (d Duration) CompareAt(u Duration, ref time.Time) int {
+	return d.AddTo(ref).Compare(u.AddTo(ref))
+}
+
+// Normalize folds the overflowing clock components of d relative to ref:
+// nanoseconds, microseconds and milliseconds into seconds, seconds into
+// minutes, minutes into hours, and hours into days. A day is the length of
+// the calendar day from the time ref+d without the clock components, so that
+// the result is the same as d when it is added to ref by AddTo. For example,
+// PT36H is P1DT12H, but it is P1DT13H from the day before the clocks are set
+// forward by one hour.
+//
+// The years, months, weeks and days are kept as they are.
+func (d Duration) Normalize(ref time.Time) Duration {
+	dir := time.Duration(1)
+	if d.Negative {
+		dir = -1
+	}
+	target := d.AddTo(ref)
+	dates := Duration{Year: d.Year, Month: d.Month, Week: d.Week, Day: d.Day, Negative: d.Negative}
+	// Each candidate is measured from ref in one step as AddTo does. Stepping
+	// from the previous candidate would keep the shift of a wall time which
+	// falls in the gap of a transition.
+	at := func(days int) time.Time {
+		n := dates
+		n.Day += days
+		return n.AddTo(ref)
+	}
+	clock := dir * target.Sub(at(0))
+	// A calendar day is 24 hours give or take a few hours, so skip the days
+	// which surely fit in the clock before counting them one by one.
+	days := 0
+	if n := int(clock/(24*time.Hour)) - 1; n > 0 {
+		if rest := dir * target.Sub(at(n)); rest >= 0 {
+			days, clock = n, rest
+		}
+	}
+	for clock > 0 {
+		rest := dir * target.Sub(at(days+1))
+		if rest < 0 {
+			break
+		}
+		days, clock = days+1, rest
+	}
+	n := dates
+	n.Day += days
+	n.Hour = int(clock / time.Hour)
+	clock -= time.Duration(n.Hour) * time.Hour
+	n.Minute = int(clock / time.Minute)
+	clock -= time.Duration(n.Minute) * time.Minute
+	n.Second = int(clock / time.Second)
+	clock -= time.Duration(n.Second) * time.Second
+	n.Millisecond = int(clock / time.Millisecond)
+	clock -= time.Duration(n.Millisecond) * time.Millisecond
+	n.Microsecond = int(clock / time.Microsecond)
+	clock -= time.Duration(n.Microsecond) * time.Microsecond
+	n.Nanosecond = int(clock)
+	return n
+}
+
 // Negate changes the sign of the duration.
 func (d Duration) Negate() Duration {
 	src := &d
@@ -689,6 +804,152 @@ func (d Duration) Negate() Duration {
 	return *dst
 }
 
+// Add returns the component-wise sum d+u. Each component of the result is the
+// sum of the components of d and u with their signs, and then the sign is
+// normalized by NormalizeSign. For example, P1M plus P1M1D is P2M1D, and PT1H
+// minus PT1M is PT59M. The components are not folded into the larger ones,
+// e.g. PT45M plus PT45M is PT90M; use Normalize for that.
+func (d Duration) Add(u Duration) Duration {
+	a, b := d.signed(), u.signed()
+	return Duration{
+		Year:        a.Year + b.Year,
+		Month:       a.Month + b.Month,
+		Week:        a.Week + b.Week,
+		Day:         a.Day + b.Day,
+		Hour:        a.Hour + b.Hour,
+		Minute:      a.Minute + b.Minute,
+		Second:      a.Second + b.Second,
+		Millisecond: a.Millisecond + b.Millisecond,
+		Microsecond: a.Microsecond + b.Microsecond,
+		Nanosecond:  a.Nanosecond + b.Nanosecond,
+	}.NormalizeSign()
+}
+
+// Sub returns the component-wise difference d-u as Add.
+func (d Duration) Sub(u Duration) Duration {
+	return d.Add(u.Negate())
+}
+
+// Mul returns the duration whose components are n times of d. The sign is
+// normalized by NormalizeSign.
+func (d Duration) Mul(n int) Duration {
+	a := d.signed()
+	return Duration{
+		Year:        a.Year * n,
+		Month:       a.Month * time.Month(n),
+		Week:        a.Week * n,
+		Day:         a.Day * n,
+		Hour:        a.Hour * n,
+		Minute:      a.Minute * n,
+		Second:      a.Second * n,
+		Millisecond: a.Millisecond * n,
+		Microsecond: a.Microsecond * n,
+		Nanosecond:  a.Nanosecond * n,
+	}.NormalizeSign()
+}
+
+// NormalizeSign returns the duration whose components are not negative, and
+// whose sign is held by Negative. The components can be negative after
+// arithmetic such as Add, e.g. PT1H and -PT1M are summed to 1 hour and -1
+// minute, which is normalized into PT59M.
+//
+// The components which have fixed ratios are borrowed from each other if
+// their signs differ: years and months, weeks and days, and the clock
+// components. The others depend on the calendar, e.g. P1M-1D can be 27 to 30
+// days, so such a duration is returned with the negative components and
+// Negative is false. It can be added to a time by AddTo, but String does not
+// return the standard ISO 8601 format for it.
+func (d Duration) NormalizeSign() Duration {
+	s := d.signed()
+	month := int(s.Month)
+	normalizeSignGroup([]*int{&s.Year, &month}, []int64{12, 1})
+	s.Month = time.Month(month)
+	normalizeSignGroup([]*int{&s.Week, &s.Day}, []int64{7, 1})
+	normalizeSignGroup(
+		[]*int{&s.Hour, &s.Minute, &s.Second, &s.Millisecond, &s.Microsecond, &s.Nanosecond},
+		[]int64{int64(time.Hour), int64(time.Minute), int64(time.Second), int64(time.Millisecond), int64(time.Microsecond), 1},
+	)
+	positive, negative := false, false
+	for _, v := range []int{s.Year, int(s.Month), s.Week, s.Day, s.Hour, s.Minute, s.Second, s.Millisecond, s.Microsecond, s.Nanosecond} {
+		positive = positive || v > 0
+		negative = negative || v < 0
+	}
+	if negative && !positive {
+		s = s.Negate().signed()
+		s.Negative = true
+	}
+	return s
+}
+
+// normalizeSignGroup borrows the values from each other if their signs are
+// mixed. units are the sizes of the values in the smallest unit.
+func normalizeSignGroup(values []*int, units []int64) {
+	positive, negative := false, false
+	var total int64
+	for i, v := range values {
+		positive = positive || *v > 0
+		negative = negative || *v < 0
+		total += int64(*v) * units[i]
+	}
+	if !positive || !negative {
+		return
+	}
+	for i, v := range values {
+		*v = int(total / units[i])
+		total -= int64(*v) * units[i]
+	}
+}
+
+// signed returns the duration whose components have the sign of d, and
+// Negative is false.
+func (d Duration) signed() Duration {
+	if !d.Negative {
+		return d
+	}
+	return Duration{
+		Year:        -d.Year,
+		Month:       -d.Month,
+		Week:        -d.Week,
+		Day:         -d.Day,
+		Hour:        -d.Hour,
+		Minute:      -d.Minute,
+		Second:      -d.Second,
+		Millisecond: -d.Millisecond,
+		Microsecond: -d.Microsecond,
+		Nanosecond:  -d.Nanosecond,
+	}
+}
+
+// Equal reports whether d and u have the same components with the same
+// signs. The components are not normalized, e.g. PT1H is not equal to PT60M,
+// but a zero duration is equal to a negative zero duration.
+func (d Duration) Equal(u Duration) bool {
+	return d.signed() == u.signed()
+}
+
+// EqualNormalized reports whether d and u are the same length after the
+// components are normalized by their fixed ratios: a year is 12 months, a week
+// is 7 days, and the clock components are converted to nanoseconds. For
+// example, P1Y is equal to P12M and PT1H is equal to PT60M. Days are not equal
+// to 24 hours, nor months to any days, as they depend on the calendar; use
+// CompareAt for them.
+func (d Duration) EqualNormalized(u Duration) bool {
+	a, b := d.signed(), u.signed()
+	return a.Year*12+int(a.Month) == b.Year*12+int(b.Month) &&
+		a.Week*7+a.Day == b.Week*7+b.Day &&
+		a.clock() == b.clock()
+}
+
+// clock returns the clock components of signed d in nanoseconds.
+func (d Duration) clock() time.Duration {
+	return time.Duration(d.Hour)*time.Hour +
+		time.Duration(d.Minute)*time.Minute +
+		time.Duration(d.Second)*time.Second +
+		time.Duration(d.Millisecond)*time.Millisecond +
+		time.Duration(d.Microsecond)*time.Microsecond +
+		time.Duration(d.Nanosecond)
+}
+
 // IsZero checks duration is zero value.
 func (d Duration) IsZero() bool {
 	return d == Duration{} || d == Duration{Negative: true}
@@ -767,6 +1028,126 @@ func (d Duration) String() string {
 	return b.String()
 }
 
+// ParseGoDuration parses a duration string in the syntax of time.ParseDuration
+// such as "1h30m" or "-1.5h". As an extension used by configurations such as
+// Kubernetes, "d" for days and "w" for weeks are also accepted, e.g. "2d" or
+// "1w2d12h".
+//
+// Days and weeks must be integers, and they are kept as the calendar
+// components. The other units are folded into hours, minutes, seconds,
+// milliseconds, microseconds and nanoseconds, e.g. "90m" is PT1H30M.
+func ParseGoDuration[bytes constraints.Bytes](b bytes) (Duration, error) {
+	return parseGoDuration(string(b))
+}
+
+func parseGoDuration(orig string) (Duration, error) {
+	var d Duration
+	s := orig
+	if s != "" && (s[0] == '-' || s[0] == '+') {
+		d.Negative = s[0] == '-'
+		s = s[1:]
+	}
+	if s == "0" {
+		return d, nil
+	}
+	if s == "" {
+		return Duration{}, &UnexpectedTokenError{
+			Value:    orig,
+			Token:    s,
+			Expected: "number",
+		}
+	}
+	var clock strings.Builder
+	for s != "" {
+		n := 0
+		for n < len(s) && (s[n]-'0' <= 9 || s[n] == '.') {
+			n++
+		}
+		if n == 0 {
+			return Duration{}, &UnexpectedTokenError{
+				Value:    orig,
+				Token:    s,
+				Expected: "number",
+			}
+		}
+		number := s[:n]
+		u := n
+		for u < len(s) && s[u]-'0' > 9 && s[u] != '.' {
+			u++
+		}
+		unit := s[n:u]
+		s = s[u:]
+		switch unit {
+		case "d", "w":
+			v, err := strconv.Atoi(number)
+			if err != nil {
+				return Duration{}, &UnexpectedTokenError{
+					Value:      orig,
+					Token:      number,
+					AfterToken: unit,
+					Expected:   "integer",
+				}
+			}
+			if unit == "d" {
+				d.Day += v
+			} else {
+				d.Week += v
+			}
+		case "":
+			return Duration{}, &UnexpectedTokenError{
+				Value:      orig,
+				Token:      number,
+				AfterToken: "",
+				Expected:   "unit",
+			}
+		default:
+			clock.WriteString(number)
+			clock.WriteString(unit)
+		}
+	}
+	if clock.Len() > 0 {
+		c, err := time.ParseDuration(clock.String())
+		if err != nil {
+			return Duration{}, fmt.Errorf("invalid duration %q: %w", orig, err)
+		}
+		d.Hour = int(c / time.Hour)
+		c -= time.Duration(d.Hour) * time.Hour
+		d.Minute = int(c / time.Minute)
+		c -= time.Duration(d.Minute) * time.Minute
+		d.Second = int(c / time.Second)
+		c -= time.Duration(d.Second) * time.Second
+		d.Millisecond = int(c / time.Millisecond)
+		c -= time.Duration(d.Millisecond) * time.Millisecond
+		d.Microsecond = int(c / time.Microsecond)
+		c -= time.Duration(d.Microsecond) * time.Microsecond
+		d.Nanosecond = int(c)
+	}
+	return d, nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// The output is the result of d.String().
+func (d Duration) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// The duration is expected to be in the ISO 8601 format accepted by
+// ParseDuration such as "PT1H30M", or in the Go format accepted by
+// ParseGoDuration such as "1h30m" or "2d".
+func (d *Duration) UnmarshalText(data []byte) error {
+	parse := ParseGoDuration[[]byte]
+	if s := strings.TrimLeft(string(data), "+-"); strings.HasPrefix(s, "P") {
+		parse = ParseDuration[[]byte]
+	}
+	parsed, err := parse(data)
+	if err != nil {
+		return err
+	}
+	*d = parsed
+	return nil
+}
+
 // DurationRangeError indicates that a value is not in an expected range for Duration.
 type DurationRangeError struct {
 	Element string
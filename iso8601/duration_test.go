@@ -1745,6 +1745,392 @@ func TestDuration_Negate(t *testing.T) {
 	}
 }
 
+func TestDuration_AddTo(t *testing.T) {
+	ref := time.Date(2023, 1, 31, 10, 0, 0, 0, time.UTC)
+	tests := []struct {
+		d    string
+		want time.Time
+	}{
+		{d: "P1M", want: time.Date(2023, 2, 28, 10, 0, 0, 0, time.UTC)},
+		{d: "P1Y1M", want: time.Date(2024, 2, 29, 10, 0, 0, 0, time.UTC)},
+		{d: "P1M1D", want: time.Date(2023, 3, 1, 10, 0, 0, 0, time.UTC)},
+		{d: "P2M", want: time.Date(2023, 3, 31, 10, 0, 0, 0, time.UTC)},
+		{d: "P1W2DT3H4M5.006S", want: time.Date(2023, 2, 9, 13, 4, 5, 6e6, time.UTC)},
+		{d: "-P1MT1H", want: time.Date(2022, 12, 31, 9, 0, 0, 0, time.UTC)},
+		{d: "PT0S", want: ref},
+	}
+	for _, tt := range tests {
+		t.Run(tt.d, func(t *testing.T) {
+			d, err := ParseDuration(tt.d)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := d.AddTo(ref); !got.Equal(tt.want) {
+				t.Errorf("want %s but got %s", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestDuration_SubFrom(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		d    string
+		t    time.Time
+		want time.Time
+	}{
+		{d: "P1M", t: time.Date(2023, 3, 31, 10, 0, 0, 0, time.UTC), want: time.Date(2023, 2, 28, 10, 0, 0, 0, time.UTC)},
+		{d: "P1Y", t: time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC), want: time.Date(2023, 2, 28, 0, 0, 0, 0, time.UTC)},
+		// The days are subtracted before the months.
+		{d: "P1M1D", t: time.Date(2023, 3, 1, 10, 0, 0, 0, time.UTC), want: time.Date(2023, 1, 28, 10, 0, 0, 0, time.UTC)},
+		// The clock is subtracted before the days.
+		{d: "P1DT2H", t: time.Date(2023, 3, 13, 1, 0, 0, 0, newYork), want: time.Date(2023, 3, 11, 23, 0, 0, 0, newYork)},
+		{d: "-P1M", t: time.Date(2023, 1, 31, 0, 0, 0, 0, time.UTC), want: time.Date(2023, 2, 28, 0, 0, 0, 0, time.UTC)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.d, func(t *testing.T) {
+			d, err := ParseDuration(tt.d)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := d.SubFrom(tt.t); !got.Equal(tt.want) {
+				t.Errorf("want %s but got %s", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestDuration_SubFromRoundTrip(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatal(err)
+	}
+	durations := []Duration{
+		{Month: 1, Day: 1, Hour: 1},
+		{Year: 1, Month: 1, Day: 30, Hour: 23, Minute: 30},
+		{Week: 1, Hour: 25},
+		{Month: 1, Day: 1, Hour: 1, Negative: true},
+		{Day: 2, Hour: 3, Negative: true},
+	}
+	// The clocks are set forward on 2023-03-12 and back on 2023-11-05, and
+	// the days are around the ends of the months.
+	for _, start := range []time.Time{
+		time.Date(2023, 1, 1, 0, 30, 0, 0, newYork),
+		time.Date(2023, 2, 25, 0, 30, 0, 0, newYork),
+		time.Date(2023, 10, 25, 0, 30, 0, 0, newYork),
+	} {
+		for ref := start; ref.Before(start.AddDate(0, 0, 21)); ref = ref.Add(53 * time.Minute) {
+			// Skip the days which are clamped by AddTo, and the second of
+			// the wall clocks which are repeated.
+			if ref.Day() > 28 || !ref.Equal(time.Date(ref.Year(), ref.Month(), ref.Day(), ref.Hour(), ref.Minute(), 0, 0, newYork)) {
+				continue
+			}
+			for _, d := range durations {
+				// Skip the dates whose wall clock falls in the gap.
+				dates := Duration{Year: d.Year, Month: d.Month, Week: d.Week, Day: d.Day, Negative: d.Negative}
+				if h, m, _ := dates.AddTo(ref).Clock(); h != ref.Hour() || m != ref.Minute() {
+					continue
+				}
+				sum := d.AddTo(ref)
+				if got := d.SubFrom(sum); !got.Equal(ref) {
+					t.Fatalf("%s from %s: want %s but got %s", d, ref, ref, got)
+				}
+			}
+		}
+	}
+}
+
+func TestDuration_CompareAt(t *testing.T) {
+	month := Duration{Month: 1}
+	days := Duration{Day: 30}
+	tests := []struct {
+		ref  time.Time
+		want int
+	}{
+		{ref: time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC), want: 1},
+		{ref: time.Date(2023, 2, 1, 0, 0, 0, 0, time.UTC), want: -1},
+		{ref: time.Date(2023, 4, 1, 0, 0, 0, 0, time.UTC), want: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.ref.Format(time.DateOnly), func(t *testing.T) {
+			if got := month.CompareAt(days, tt.ref); got != tt.want {
+				t.Errorf("want %d but got %d", tt.want, got)
+			}
+			if got := days.CompareAt(month, tt.ref); got != -tt.want {
+				t.Errorf("want %d for the inverse but got %d", -tt.want, got)
+			}
+		})
+	}
+}
+
+func TestDuration_Normalize(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatal(err)
+	}
+	utc := time.Date(2023, 3, 12, 0, 0, 0, 0, time.UTC)
+	// The clocks are set forward by one hour on 2023-03-12 in New York.
+	dst := time.Date(2023, 3, 12, 0, 0, 0, 0, newYork)
+	tests := []struct {
+		name string
+		d    Duration
+		ref  time.Time
+		want Duration
+	}{
+		{
+			name: "clock",
+			d:    Duration{Minute: 90, Second: 61, Millisecond: 1500},
+			ref:  utc,
+			want: Duration{Hour: 1, Minute: 31, Second: 2, Millisecond: 500},
+		},
+		{
+			name: "hours into days",
+			d:    Duration{Month: 1, Hour: 36},
+			ref:  utc,
+			want: Duration{Month: 1, Day: 1, Hour: 12},
+		},
+		{
+			name: "short day",
+			d:    Duration{Hour: 36},
+			ref:  dst,
+			want: Duration{Day: 1, Hour: 13},
+		},
+		{
+			name: "short day backward",
+			d:    Duration{Hour: 36, Negative: true},
+			ref:  dst.AddDate(0, 0, 1),
+			want: Duration{Day: 1, Hour: 13, Negative: true},
+		},
+		{
+			name: "many days",
+			d:    Duration{Week: 1, Hour: 24*400 + 1},
+			ref:  dst,
+			want: Duration{Week: 1, Day: 400, Hour: 1},
+		},
+		{
+			name: "less than a day",
+			d:    Duration{Day: 1, Hour: 23, Minute: 59},
+			ref:  utc,
+			want: Duration{Day: 1, Hour: 23, Minute: 59},
+		},
+		{
+			name: "wall time in the gap",
+			d:    Duration{Day: 2, Hour: 179, Minute: 18, Second: 2488},
+			ref:  time.Date(2023, 3, 4, 2, 14, 20, 0, newYork),
+			want: Duration{Day: 9, Hour: 12, Minute: 59, Second: 28},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.d.Normalize(tt.ref)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("(-want, +got)\n%s", diff)
+			}
+			if want, got := tt.d.AddTo(tt.ref), got.AddTo(tt.ref); !got.Equal(want) {
+				t.Errorf("want %s but got %s after AddTo", want, got)
+			}
+		})
+	}
+}
+
+func TestDuration_NormalizeTransitions(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatal(err)
+	}
+	durations := []Duration{
+		{Hour: 36},
+		{Day: 2, Hour: 179, Minute: 18, Second: 2488},
+		{Month: 1, Hour: 23, Minute: 30},
+		{Week: 1, Hour: 24*10 + 1},
+		{Hour: 47, Minute: 59, Negative: true},
+		{Day: 1, Hour: 200, Second: 1, Negative: true},
+	}
+	// The clocks are set forward on 2023-03-12 and back on 2023-11-05.
+	for _, start := range []time.Time{
+		time.Date(2023, 2, 25, 0, 0, 0, 0, newYork),
+		time.Date(2023, 10, 29, 0, 0, 0, 0, newYork),
+	} {
+		for ref := start; ref.Before(start.AddDate(0, 0, 21)); ref = ref.Add(17 * time.Minute) {
+			for _, d := range durations {
+				n := d.Normalize(ref)
+				if want, got := d.AddTo(ref), n.AddTo(ref); !got.Equal(want) {
+					t.Fatalf("%s from %s: want %s but got %s by %s", d, ref, want, got, n)
+				}
+			}
+		}
+	}
+}
+
+func TestDuration_Arithmetic(t *testing.T) {
+	mustParse := func(s string) Duration {
+		t.Helper()
+		d, err := ParseDuration(s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return d
+	}
+	tests := []struct {
+		name string
+		got  Duration
+		want Duration
+	}{
+		{name: "add", got: mustParse("P1M").Add(mustParse("P1M1D")), want: Duration{Month: 2, Day: 1}},
+		{name: "add negative", got: mustParse("-P1D").Add(mustParse("-PT1H")), want: Duration{Day: 1, Hour: 1, Negative: true}},
+		{name: "add not folded", got: mustParse("PT45M").Add(mustParse("PT45M")), want: Duration{Minute: 90}},
+		{name: "sub clock", got: mustParse("PT1H").Sub(mustParse("PT1M")), want: Duration{Minute: 59}},
+		{name: "sub to negative", got: mustParse("PT1M").Sub(mustParse("PT1H")), want: Duration{Minute: 59, Negative: true}},
+		{name: "sub years", got: mustParse("P1Y").Sub(mustParse("P1M")), want: Duration{Month: 11}},
+		{name: "sub weeks", got: mustParse("P1W").Sub(mustParse("P1D")), want: Duration{Day: 6}},
+		{name: "sub mixed", got: mustParse("P1M").Sub(mustParse("P1D")), want: Duration{Month: 1, Day: -1}},
+		{name: "sub zero", got: mustParse("P1D").Sub(mustParse("P1D")), want: Duration{}},
+		{name: "mul", got: mustParse("P1DT1H30M").Mul(3), want: Duration{Day: 3, Hour: 3, Minute: 90}},
+		{name: "mul negative", got: mustParse("-PT1S").Mul(-2), want: Duration{Second: 2}},
+		{name: "mul by negative", got: mustParse("P1M").Mul(-2), want: Duration{Month: 2, Negative: true}},
+		{name: "mul zero", got: mustParse("P1M").Mul(0), want: Duration{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if diff := cmp.Diff(tt.want, tt.got); diff != "" {
+				t.Errorf("(-want, +got)\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDuration_NormalizeSign(t *testing.T) {
+	tests := []struct {
+		d    Duration
+		want Duration
+	}{
+		{d: Duration{Day: -1, Hour: -2}, want: Duration{Day: 1, Hour: 2, Negative: true}},
+		{d: Duration{Day: -1, Hour: -2, Negative: true}, want: Duration{Day: 1, Hour: 2}},
+		{d: Duration{Year: 1, Month: -13}, want: Duration{Month: 1, Negative: true}},
+		{d: Duration{Hour: -1, Second: 1, Nanosecond: 1}, want: Duration{Minute: 59, Second: 58, Millisecond: 999, Microsecond: 999, Nanosecond: 999, Negative: true}},
+		{d: Duration{Month: 1, Day: -1, Negative: true}, want: Duration{Month: -1, Day: 1}},
+		{d: Duration{Hour: 1}, want: Duration{Hour: 1}},
+		{d: Duration{Negative: true}, want: Duration{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.d.String(), func(t *testing.T) {
+			got := tt.d.NormalizeSign()
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("(-want, +got)\n%s", diff)
+			}
+			ref := time.Date(2023, 1, 15, 0, 0, 0, 0, time.UTC)
+			if want, got := tt.d.AddTo(ref), got.AddTo(ref); !got.Equal(want) {
+				t.Errorf("want %s but got %s after AddTo", want, got)
+			}
+		})
+	}
+}
+
+func TestDuration_Equal(t *testing.T) {
+	tests := []struct {
+		d, u       Duration
+		equal      bool
+		normalized bool
+	}{
+		{d: Duration{Hour: 1}, u: Duration{Hour: 1}, equal: true, normalized: true},
+		{d: Duration{Hour: 1}, u: Duration{Minute: 60}, equal: false, normalized: true},
+		{d: Duration{Year: 1}, u: Duration{Month: 12}, equal: false, normalized: true},
+		{d: Duration{Week: 1}, u: Duration{Day: 7}, equal: false, normalized: true},
+		{d: Duration{Day: 1}, u: Duration{Hour: 24}, equal: false, normalized: false},
+		{d: Duration{Month: 1}, u: Duration{Day: 30}, equal: false, normalized: false},
+		{d: Duration{Day: 1, Negative: true}, u: Duration{Day: -1}, equal: true, normalized: true},
+		{d: Duration{Negative: true}, u: Duration{}, equal: true, normalized: true},
+		{d: Duration{Day: 1, Negative: true}, u: Duration{Day: 1}, equal: false, normalized: false},
+		{d: Duration{Hour: 1, Minute: -60}, u: Duration{}, equal: false, normalized: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.d.String()+"="+tt.u.String(), func(t *testing.T) {
+			if got := tt.d.Equal(tt.u); got != tt.equal {
+				t.Errorf("want Equal %v but got %v", tt.equal, got)
+			}
+			if got := tt.d.EqualNormalized(tt.u); got != tt.normalized {
+				t.Errorf("want EqualNormalized %v but got %v", tt.normalized, got)
+			}
+		})
+	}
+}
+
+func TestParseGoDuration(t *testing.T) {
+	tests := []struct {
+		s    string
+		want Duration
+	}{
+		{s: "1h30m", want: Duration{Hour: 1, Minute: 30}},
+		{s: "90m", want: Duration{Hour: 1, Minute: 30}},
+		{s: "-1.5h", want: Duration{Hour: 1, Minute: 30, Negative: true}},
+		{s: "+300ms", want: Duration{Millisecond: 300}},
+		{s: "1µs2ns", want: Duration{Microsecond: 1, Nanosecond: 2}},
+		{s: "2d", want: Duration{Day: 2}},
+		{s: "1w2d12h", want: Duration{Week: 1, Day: 2, Hour: 12}},
+		{s: "36h", want: Duration{Hour: 36}},
+		{s: "0", want: Duration{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.s, func(t *testing.T) {
+			got, err := ParseGoDuration(tt.s)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("(-want, +got)\n%s", diff)
+			}
+		})
+	}
+	for _, s := range []string{"", "-", "1", "h", "1.5d", "1x", "1h-2m", "..1h"} {
+		t.Run(s, func(t *testing.T) {
+			if _, err := ParseGoDuration(s); err == nil {
+				t.Error("want error")
+			}
+		})
+	}
+}
+
+func TestDuration_UnmarshalText(t *testing.T) {
+	tests := []struct {
+		s    string
+		want Duration
+	}{
+		{s: "PT1H30M", want: Duration{Hour: 1, Minute: 30}},
+		{s: "-P1D", want: Duration{Day: 1, Negative: true}},
+		{s: "1h30m", want: Duration{Hour: 1, Minute: 30}},
+		{s: "-2d", want: Duration{Day: 2, Negative: true}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.s, func(t *testing.T) {
+			var got Duration
+			if err := got.UnmarshalText([]byte(tt.s)); err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("(-want, +got)\n%s", diff)
+			}
+			text, err := got.MarshalText()
+			if err != nil {
+				t.Fatal(err)
+			}
+			var back Duration
+			if err := back.UnmarshalText(text); err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(got, back); diff != "" {
+				t.Errorf("round trip %s: (-want, +got)\n%s", text, diff)
+			}
+		})
+	}
+	var d Duration
+	if err := d.UnmarshalText([]byte("P1X")); err == nil {
+		t.Error("want error")
+	}
+}
+
 func TestDuration_String(t *testing.T) {
 	tests := []struct {
 		d    Duration
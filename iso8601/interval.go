@@ -3,6 +3,7 @@ package iso8601
 import (
 	"bytes"
 	"fmt"
+	"iter"
 	"time"
 
 	"github.com/Code-Hex/synchro/internal/constraints"
@@ -23,6 +24,11 @@ type Interval struct {
 
 	// Repeat represents the number of times the interval should be repeated. -1 indicates infinity.
 	repeat int
+
+	// startUnbounded and endUnbounded report whether the start or the end is
+	// open ("..") in the ISO 8601-2 notation.
+	startUnbounded bool
+	endUnbounded   bool
 }
 
 // Start returns a time.Time representing the beginning of this interval.
@@ -33,13 +39,19 @@ type Interval struct {
 // actually be zero value (January 1, year 1, 00:00:00 UTC.) of the following
 // day (since the interval covers the entire day). Intervals include the start
 // value (in contrast to the end value).
+//
+// The start of the "duration/end" form is calculated by Duration.SubFrom:
+// years, months, weeks and days are subtracted by the calendar, not by the
+// averaged lengths of StdDuration. For example, the start of
+// "P1M/2023-03-15T00:00:00Z" is 2023-02-15T00:00:00Z. If the month does not
+// have the day of the end, the day is clamped to the last day of the month, so
+// the start of "P1M/2023-03-31T00:00:00Z" is 2023-02-28T00:00:00Z.
 func (i Interval) Start() time.Time {
 	if !i.start.IsZero() {
 		return i.start
 	}
 	if !i.end.IsZero() {
-		// Assuming the Duration struct has a method to convert it to time.Duration
-		return i.end.Add(-i.duration.StdDuration())
+		return addDuration(i.end, i.duration, -1)
 	}
 	return time.Time{}
 }
@@ -51,12 +63,17 @@ func (i Interval) Start() time.Time {
 // Note: if the interval doesn't include a time component, the end
 // time will actually be zero value (January 1, year 1, 00:00:00 UTC.) of
 // the following day (since the interval covers the entire day).
+//
+// The end of the "start/duration" form is calculated by Duration.AddTo as
+// Start. For example, the end of "2023-01-15T00:00:00Z/P1M" is
+// 2023-02-15T00:00:00Z, and the end of "2023-01-31T00:00:00Z/P1M" is
+// 2023-02-28T00:00:00Z.
 func (i Interval) End() time.Time {
 	if !i.end.IsZero() {
 		return i.end
 	}
 	if !i.start.IsZero() {
-		return i.start.Add(i.duration.StdDuration())
+		return addDuration(i.start, i.duration, 1)
 	}
 	return time.Time{}
 }
@@ -69,10 +86,160 @@ func (i Interval) Duration() Duration {
 	return NewDuration(i.end.Sub(i.start))
 }
 
+// StartUnbounded reports whether the start of the interval is open, which is
+// written as ".." in ISO 8601-2 such as "../2023-01-01". Start returns the zero
+// time for the unbounded start.
+func (i Interval) StartUnbounded() bool {
+	return i.startUnbounded
+}
+
+// EndUnbounded reports whether the end of the interval is open, which is
+// written as ".." in ISO 8601-2 such as "2023-01-01/..". End returns the zero
+// time for the unbounded end.
+func (i Interval) EndUnbounded() bool {
+	return i.endUnbounded
+}
+
+// Repeat returns the number of times the interval is repeated, which is
+// written as "Rn/" such as "R5/2023-01-01/P1D". -1 means infinity, and 0 means
+// the interval is not repeated.
+func (i Interval) Repeat() int {
+	return i.repeat
+}
+
+// NewInterval makes the Interval between start and end.
+func NewInterval(start, end time.Time) Interval {
+	return Interval{start: start, end: end}
+}
+
+// WithRepeat returns the interval which is repeated n times. -1 means infinity.
+func (i Interval) WithRepeat(n int) Interval {
+	i.repeat = n
+	return i
+}
+
+// WithStartUnbounded returns the interval whose start is open.
+func (i Interval) WithStartUnbounded() Interval {
+	i.start, i.startUnbounded = time.Time{}, true
+	return i
+}
+
+// WithEndUnbounded returns the interval whose end is open.
+func (i Interval) WithEndUnbounded() Interval {
+	i.end, i.endUnbounded = time.Time{}, true
+	return i
+}
+
+// Occurrences returns an iterator over the occurrences of the repeating
+// interval. The number of occurrences is Repeat, or it is infinite if Repeat is
+// -1 and the iteration continues until the loop is broken. An interval which
+// does not repeat has one occurrence.
+//
+// Each occurrence starts when the previous one ends. The occurrences of the
+// "duration/end" form go back in time from the end, as the end is the end of
+// the last occurrence. Durations are applied by the calendar as Start and End,
+// and the occurrences are calculated from the first one so that the day of
+// month does not drift, e.g. "R3/2023-01-31T00:00:00Z/P1M" starts on Jan 31,
+// Feb 28 and Mar 31.
+//
+// An interval which has only a duration has no occurrences, and an unbounded
+// interval has only itself.
+func (i Interval) Occurrences() iter.Seq[Interval] {
+	return func(yield func(Interval) bool) {
+		if i.startUnbounded || i.endUnbounded {
+			i.repeat = 0
+			yield(i)
+			return
+		}
+		var occurrence func(k int) Interval
+		switch {
+		case !i.start.IsZero() && !i.end.IsZero():
+			d := i.end.Sub(i.start)
+			occurrence = func(k int) Interval {
+				start := i.start.Add(time.Duration(k) * d)
+				return NewInterval(start, start.Add(d))
+			}
+		case !i.start.IsZero():
+			occurrence = func(k int) Interval {
+				return NewInterval(addDuration(i.start, i.duration, k), addDuration(i.start, i.duration, k+1))
+			}
+		case !i.end.IsZero():
+			occurrence = func(k int) Interval {
+				return NewInterval(addDuration(i.end, i.duration, -k-1), addDuration(i.end, i.duration, -k))
+			}
+		default:
+			return
+		}
+		n := i.repeat
+		if n == 0 {
+			n = 1
+		}
+		for k := 0; n < 0 || k < n; k++ {
+			if !yield(occurrence(k)) {
+				return
+			}
+		}
+	}
+}
+
+// String returns the ISO 8601 representation of the interval, which can be
+// parsed by ParseInterval into the same interval. The times are written in
+// RFC 3339 format with sub-second precision, and "/" is used as the designator.
+// For example: "R5/2023-01-01T00:00:00Z/P1D".
+func (i Interval) String() string {
+	b := make([]byte, 0, len("R/2006-01-02T15:04:05.999999999Z07:00/2006-01-02T15:04:05.999999999Z07:00"))
+	switch {
+	case i.repeat < 0:
+		b = append(b, "R/"...)
+	case i.repeat > 0:
+		b = fmt.Appendf(b, "R%d/", i.repeat)
+	}
+	if !i.startUnbounded && !i.endUnbounded && i.start.IsZero() && i.end.IsZero() {
+		return string(append(b, i.duration.String()...))
+	}
+	switch {
+	case i.startUnbounded:
+		b = append(b, unbounded...)
+	case i.start.IsZero():
+		b = append(b, i.duration.String()...)
+	default:
+		b = i.start.AppendFormat(b, time.RFC3339Nano)
+	}
+	b = append(b, '/')
+	switch {
+	case i.endUnbounded:
+		b = append(b, unbounded...)
+	case i.end.IsZero():
+		b = append(b, i.duration.String()...)
+	default:
+		b = i.end.AppendFormat(b, time.RFC3339Nano)
+	}
+	return string(b)
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// The output is the result of i.String().
+func (i Interval) MarshalText() ([]byte, error) {
+	return []byte(i.String()), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// The interval is expected to be a string in a format accepted by ParseInterval.
+func (i *Interval) UnmarshalText(data []byte) error {
+	parsed, err := ParseInterval(data)
+	if err != nil {
+		return err
+	}
+	*i = parsed
+	return nil
+}
+
 // Contains returns a boolean indicating whether the provided time.Time
 // is between the Start or End dates as defined by this interval.
+// The unbounded start or end is not checked.
 func (i Interval) Contains(t time.Time) bool {
-	return t.Compare(i.Start()) >= 0 && t.Compare(i.End()) <= 0
+	return (i.startUnbounded || t.Compare(i.Start()) >= 0) &&
+		(i.endUnbounded || t.Compare(i.End()) <= 0)
 }
 
 // ParseInterval parses an ISO8601 time interval from a byte slice or string.
@@ -168,8 +335,51 @@ func parseInterval(b []byte) (Interval, error) {
 	}
 
 	startb := b[:designatorIdx]
+	endb := b[len(designator)+designatorIdx:]
 	isStartDurationFormat := b[0] == 'P'
 
+	// ISO 8601-2 open interval such as "2023-01-01/.." and "../2023-01-01".
+	startUnbounded, endUnbounded := bytes.Equal(startb, unbounded), bytes.Equal(endb, unbounded)
+	if startUnbounded || endUnbounded {
+		if startUnbounded && !endUnbounded {
+			if len(endb) == 0 || endb[0] == 'P' {
+				return Interval{}, &UnexpectedTokenError{
+					Value:      string(b),
+					Token:      string(endb),
+					AfterToken: string(designator),
+					Expected:   "datetime format",
+				}
+			}
+			dt, err := parseDateTime(endb)
+			if err != nil {
+				return Interval{}, err
+			}
+			end = dt
+		}
+		if endUnbounded && !startUnbounded {
+			if isStartDurationFormat {
+				return Interval{}, &UnexpectedTokenError{
+					Value:      string(b),
+					Token:      string(startb),
+					AfterToken: "",
+					Expected:   "datetime format",
+				}
+			}
+			dt, err := parseDateTime(startb)
+			if err != nil {
+				return Interval{}, err
+			}
+			start = dt
+		}
+		return Interval{
+			start:          start,
+			end:            end,
+			repeat:         repeat,
+			startUnbounded: startUnbounded,
+			endUnbounded:   endUnbounded,
+		}, nil
+	}
+
 	if isStartDurationFormat {
 		d, err := parseDuration(startb)
 		if err != nil {
@@ -184,7 +394,6 @@ func parseInterval(b []byte) (Interval, error) {
 		start = dt
 	}
 
-	endb := b[len(designator)+designatorIdx:]
 	if endb[0] == 'P' {
 		if !duration.IsZero() {
 			return Interval{}, &UnexpectedTokenError{
@@ -221,6 +430,9 @@ func parseInterval(b []byte) (Interval, error) {
 	}, nil
 }
 
+// unbounded is the open start or end of an interval in ISO 8601-2.
+var unbounded = []byte("..")
+
 func parseShortEndDuration(start time.Time, b []byte) (time.Time, error) {
 	var (
 		year, m, day         = start.Date()
@@ -288,6 +288,46 @@ func TestParseInterval(t *testing.T) {
 				repeat: 0,
 			},
 		},
+		// ISO 8601-2 open intervals
+		{
+			name: "2023-01-01/..",
+			want: Interval{
+				start:        time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC),
+				endUnbounded: true,
+			},
+		},
+		{
+			name: "../2023-01-01T09:00:00+09:00",
+			want: Interval{
+				end:            time.Date(2023, 1, 1, 9, 0, 0, 0, time.FixedZone("", 9*60*60)),
+				startUnbounded: true,
+			},
+		},
+		{
+			name: "../..",
+			want: Interval{
+				startUnbounded: true,
+				endUnbounded:   true,
+			},
+		},
+		{
+			name: "P1D/..",
+			wantErr: &UnexpectedTokenError{
+				Value:      "P1D/..",
+				Token:      "P1D",
+				AfterToken: "",
+				Expected:   "datetime format",
+			},
+		},
+		{
+			name: "../P1D",
+			wantErr: &UnexpectedTokenError{
+				Value:      "../P1D",
+				Token:      "P1D",
+				AfterToken: "/",
+				Expected:   "datetime format",
+			},
+		},
 		{
 			name: "",
 			wantErr: &UnexpectedTokenError{
@@ -828,6 +868,30 @@ func TestInterval_Contains(t *testing.T) {
 			t:     time.Date(2013, 1, 10, 23, 59, 59, 0, time.UTC),
 			want:  true,
 		},
+		{
+			name:  "2013-01-01/.. contains 9999-12-31",
+			parse: "2013-01-01/..",
+			t:     time.Date(9999, 12, 31, 0, 0, 0, 0, time.UTC),
+			want:  true,
+		},
+		{
+			name:  "2013-01-01/.. does not contain 2012-12-31",
+			parse: "2013-01-01/..",
+			t:     time.Date(2012, 12, 31, 0, 0, 0, 0, time.UTC),
+			want:  false,
+		},
+		{
+			name:  "../2013-01-10 contains 0001-01-01",
+			parse: "../2013-01-10",
+			t:     time.Time{},
+			want:  true,
+		},
+		{
+			name:  "../2013-01-10 does not contain 2013-01-11",
+			parse: "../2013-01-10",
+			t:     time.Date(2013, 1, 11, 0, 0, 0, 0, time.UTC),
+			want:  false,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -877,6 +941,30 @@ func TestInterval_Start(t *testing.T) {
 			}
 		})
 	}
+
+	// The start of "duration/end" is calculated by the calendar, not by
+	// the averaged length of StdDuration.
+	calendarCases := []struct {
+		interval string
+		expected time.Time
+	}{
+		{interval: "P1M/2023-03-15T00:00:00Z", expected: time.Date(2023, time.February, 15, 0, 0, 0, 0, time.UTC)},
+		{interval: "P1M/2023-03-31T00:00:00Z", expected: time.Date(2023, time.February, 28, 0, 0, 0, 0, time.UTC)},
+		{interval: "P1M/2024-03-31T00:00:00Z", expected: time.Date(2024, time.February, 29, 0, 0, 0, 0, time.UTC)},
+		{interval: "P1Y/2024-02-29T00:00:00Z", expected: time.Date(2023, time.February, 28, 0, 0, 0, 0, time.UTC)},
+		{interval: "P1M1D/2023-03-01T00:00:00Z", expected: time.Date(2023, time.January, 28, 0, 0, 0, 0, time.UTC)},
+	}
+	for _, tc := range calendarCases {
+		t.Run(tc.interval, func(t *testing.T) {
+			interval, err := ParseInterval(tc.interval)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := interval.Start(); !got.Equal(tc.expected) {
+				t.Errorf("Expected start time %v, but got %v", tc.expected, got)
+			}
+		})
+	}
 }
 
 func TestInterval_End(t *testing.T) {
@@ -900,6 +988,22 @@ func TestInterval_End(t *testing.T) {
 			},
 			expected: time.Date(2022, time.January, 2, 0, 0, 0, 0, time.UTC),
 		},
+		{
+			name: "Start time and months are set",
+			interval: Interval{
+				start:    time.Date(2022, time.January, 15, 0, 0, 0, 0, time.UTC),
+				duration: Duration{Month: 1, Hour: 1},
+			},
+			expected: time.Date(2022, time.February, 15, 1, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "Start time at the end of month and months are set",
+			interval: Interval{
+				start:    time.Date(2023, time.January, 31, 0, 0, 0, 0, time.UTC),
+				duration: Duration{Month: 1},
+			},
+			expected: time.Date(2023, time.February, 28, 0, 0, 0, 0, time.UTC),
+		},
 		{
 			name:     "Neither start time, end time, nor duration are set",
 			interval: Interval{},
@@ -953,6 +1057,153 @@ func TestInterval_Duration(t *testing.T) {
 	}
 }
 
+func TestNewInterval(t *testing.T) {
+	start := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2023, 1, 2, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name string
+		got  Interval
+		want string
+	}{
+		{name: "start/end", got: NewInterval(start, end), want: "2023-01-01T00:00:00Z/2023-01-02T00:00:00Z"},
+		{name: "repeat", got: NewInterval(start, end).WithRepeat(-1), want: "R/2023-01-01T00:00:00Z/2023-01-02T00:00:00Z"},
+		{name: "since", got: NewInterval(start, time.Time{}).WithEndUnbounded(), want: "2023-01-01T00:00:00Z/.."},
+		{name: "until", got: NewInterval(start, end).WithStartUnbounded(), want: "../2023-01-02T00:00:00Z"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			want, err := ParseInterval(tt.want)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(want, tt.got, cmp.AllowUnexported(Interval{})); diff != "" {
+				t.Errorf("(-want, +got)\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestInterval_String(t *testing.T) {
+	tests := []struct {
+		parse string
+		want  string
+	}{
+		{parse: "2007-03-01T13:00:00Z/2008-05-11T15:30:00Z", want: "2007-03-01T13:00:00Z/2008-05-11T15:30:00Z"},
+		{parse: "2007-03-01T13:00:00Z/P1Y2M10DT2H30M", want: "2007-03-01T13:00:00Z/P1Y2M10DT2H30M"},
+		{parse: "P1Y2M10DT2H30M/2008-05-11T15:30:00Z", want: "P1Y2M10DT2H30M/2008-05-11T15:30:00Z"},
+		{parse: "P1Y2M10DT2H30M", want: "P1Y2M10DT2H30M"},
+		{parse: "2007-03-01T13:00:00Z--2008-05-11T15:30:00Z", want: "2007-03-01T13:00:00Z/2008-05-11T15:30:00Z"},
+		{parse: "20070301T130000Z/20080511T153000Z", want: "2007-03-01T13:00:00Z/2008-05-11T15:30:00Z"},
+		{parse: "2007-12-14T13:30/15:30", want: "2007-12-14T13:30:00Z/2007-12-14T15:30:00Z"},
+		{parse: "2008-02-15/03-14", want: "2008-02-15T00:00:00Z/2008-03-14T00:00:00Z"},
+		{parse: "2007-03-01T13:00:00.5+02:00/PT0.5S", want: "2007-03-01T13:00:00.5+02:00/PT0.500000000S"},
+		{parse: "R5/2008-03-01T13:00:00Z/P1Y2M10DT2H30M", want: "R5/2008-03-01T13:00:00Z/P1Y2M10DT2H30M"},
+		{parse: "R/P1Y2M10DT2H30M/2008-05-11T15:30:00Z", want: "R/P1Y2M10DT2H30M/2008-05-11T15:30:00Z"},
+		{parse: "R12--P1D", want: "R12/P1D"},
+		{parse: "R0/P1D", want: "P1D"},
+		{parse: "2023-01-01/..", want: "2023-01-01T00:00:00Z/.."},
+		{parse: "../2023-01-01", want: "../2023-01-01T00:00:00Z"},
+		{parse: "../..", want: "../.."},
+	}
+	for _, tt := range tests {
+		t.Run(tt.parse, func(t *testing.T) {
+			i, err := ParseInterval(tt.parse)
+			if err != nil {
+				t.Fatal(err)
+			}
+			text, err := i.MarshalText()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := string(text); got != tt.want {
+				t.Errorf("want %q but got %q", tt.want, got)
+			}
+			var got Interval
+			if err := got.UnmarshalText(text); err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(i, got, cmp.AllowUnexported(Interval{})); diff != "" {
+				t.Errorf("(-want, +got)\n%s", diff)
+			}
+		})
+	}
+	var i Interval
+	if err := i.UnmarshalText([]byte("R5/")); err == nil {
+		t.Error("want error")
+	}
+}
+
+func TestInterval_Occurrences(t *testing.T) {
+	date := func(month time.Month, day int) time.Time {
+		return time.Date(2023, month, day, 0, 0, 0, 0, time.UTC)
+	}
+	tests := []struct {
+		parse string
+		limit int
+		want  []Interval
+	}{
+		{
+			parse: "R3/2023-01-31T00:00:00Z/P1M",
+			limit: 10,
+			want: []Interval{
+				NewInterval(date(1, 31), date(2, 28)),
+				NewInterval(date(2, 28), date(3, 31)),
+				NewInterval(date(3, 31), date(4, 30)),
+			},
+		},
+		{
+			parse: "R2/P1M/2023-03-31T00:00:00Z",
+			limit: 10,
+			want: []Interval{
+				NewInterval(date(2, 28), date(3, 31)),
+				NewInterval(date(1, 31), date(2, 28)),
+			},
+		},
+		{
+			parse: "R/2023-01-01T00:00:00Z/2023-01-02T12:00:00Z",
+			limit: 3,
+			want: []Interval{
+				NewInterval(date(1, 1), date(1, 2).Add(12*time.Hour)),
+				NewInterval(date(1, 2).Add(12*time.Hour), date(1, 4)),
+				NewInterval(date(1, 4), date(1, 5).Add(12*time.Hour)),
+			},
+		},
+		{
+			parse: "2023-01-01T00:00:00Z/P1D",
+			limit: 10,
+			want:  []Interval{NewInterval(date(1, 1), date(1, 2))},
+		},
+		{
+			parse: "R2/2023-01-01T00:00:00Z/..",
+			limit: 10,
+			want:  []Interval{NewInterval(date(1, 1), time.Time{}).WithEndUnbounded()},
+		},
+		{
+			parse: "R/P1D",
+			limit: 10,
+			want:  nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.parse, func(t *testing.T) {
+			i, err := ParseInterval(tt.parse)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var got []Interval
+			for o := range i.Occurrences() {
+				if len(got) == tt.limit {
+					break
+				}
+				got = append(got, o)
+			}
+			if diff := cmp.Diff(tt.want, got, cmp.AllowUnexported(Interval{})); diff != "" {
+				t.Errorf("(-want, +got)\n%s", diff)
+			}
+		})
+	}
+}
+
 func TestIntervalRangeError_Error(t *testing.T) {
 	tests := []struct {
 		name string
@@ -0,0 +1,261 @@
+package synchro
+
+import (
+	"bytes"
+	"fmt"
+	"time"
+
+	"github.com/Code-Hex/synchro/internal/constraints"
+	"github.com/Code-Hex/synchro/iso8601"
+)
+
+// LocalDateTime represents a civil date and time without a timezone,
+// such as "every day at 09:00 wall clock".
+//
+// A LocalDateTime does not represent an instant until it is bound to a
+// timezone with LocalIn.
+type LocalDateTime struct {
+	Date iso8601.Date
+	Time iso8601.Time
+}
+
+// NewLocalDateTime returns the LocalDateTime corresponding to
+//
+//	yyyy-mm-dd hh:mm:ss + nsec nanoseconds
+//
+// The month, day, hour, min, sec, and nsec values may be outside
+// their usual ranges and will be normalized during the conversion.
+// For example, October 32 converts to November 1.
+func NewLocalDateTime(year int, month time.Month, day int, hour int, min int, sec int, nsec int) LocalDateTime {
+	return LocalDateTimeOf(time.Date(year, month, day, hour, min, sec, nsec, time.UTC))
+}
+
+// LocalDateTimeOf returns the LocalDateTime representing the wall clock of t
+// in t's location.
+func LocalDateTimeOf(t time.Time) LocalDateTime {
+	return LocalDateTime{
+		Date: iso8601.DateOf(t),
+		Time: iso8601.TimeOf(t),
+	}
+}
+
+// LocalDateTime returns the wall clock of t in the timezone T.
+func (t Time[T]) LocalDateTime() LocalDateTime {
+	return LocalDateTimeOf(t.tm)
+}
+
+// ParseLocalDateTime parses an ISO8601-compliant date or datetime string without
+// a time zone designator and returns the LocalDateTime it represents.
+// The value must be in a format accepted by iso8601.ParseDateTime, e.g. "2023-09-02T09:00".
+//
+// An error is returned if the value has a time zone designator such as "Z" or "+09:00".
+func ParseLocalDateTime[bytes constraints.Bytes](b bytes) (LocalDateTime, error) {
+	return parseLocalDateTime([]byte(b))
+}
+
+func parseLocalDateTime(b []byte) (LocalDateTime, error) {
+	if i := bytes.IndexByte(b, 'T'); i >= 0 {
+		if j := bytes.IndexAny(b[i:], "Z+-"); j >= 0 {
+			return LocalDateTime{}, &iso8601.UnexpectedTokenError{
+				Value:      string(b),
+				Token:      string(b[i+j:]),
+				AfterToken: string(b[:i+j]),
+				Expected:   "local time without time zone designator",
+			}
+		}
+	}
+	tm, err := iso8601.ParseDateTime(b, iso8601.WithInLocation(time.UTC))
+	if err != nil {
+		return LocalDateTime{}, err
+	}
+	return LocalDateTimeOf(tm), nil
+}
+
+// String returns the ISO8601 string representation of the format "YYYY-MM-DDThh:mm:ss".
+// For example: "2023-09-02T09:00:00".
+func (l LocalDateTime) String() string {
+	return l.Date.String() + "T" + l.Time.String()
+}
+
+// IsZero reports whether l is the zero value.
+func (l LocalDateTime) IsZero() bool {
+	return l.Date.IsZero() && l.Time.IsZero()
+}
+
+// Before reports whether l occurs before u.
+func (l LocalDateTime) Before(u LocalDateTime) bool {
+	if l.Date != u.Date {
+		return l.Date.Before(u.Date)
+	}
+	return l.Time.Before(u.Time)
+}
+
+// After reports whether l occurs after u.
+func (l LocalDateTime) After(u LocalDateTime) bool {
+	return u.Before(l)
+}
+
+// Compare compares l with u. If l is before u, it returns -1;
+// if l is after u, it returns +1; if they're the same, it returns 0.
+func (l LocalDateTime) Compare(u LocalDateTime) int {
+	switch {
+	case l.Before(u):
+		return -1
+	case l.After(u):
+		return 1
+	}
+	return 0
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// The output is the result of l.String().
+func (l LocalDateTime) MarshalText() ([]byte, error) {
+	return []byte(l.String()), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// The value is expected to be a string in a format accepted by ParseLocalDateTime.
+func (l *LocalDateTime) UnmarshalText(data []byte) error {
+	var err error
+	*l, err = parseLocalDateTime(data)
+	return err
+}
+
+// UTC returns the wall clock l as a time in UTC, so that the calendar
+// arithmetic on it is not affected by the daylight saving time.
+func (l LocalDateTime) UTC() time.Time {
+	return time.Date(
+		l.Date.Year, l.Date.Month, l.Date.Day,
+		l.Time.Hour, l.Time.Minute, l.Time.Second, l.Time.Nanosecond,
+		time.UTC,
+	)
+}
+
+// LocalResultKind describes how a wall clock time maps to instants in a timezone.
+type LocalResultKind int
+
+const (
+	// LocalSingle means the wall clock time maps to exactly one instant.
+	LocalSingle LocalResultKind = iota
+	// LocalRepeated means the wall clock time occurred twice, typically
+	// because clocks were turned back at the end of daylight saving time.
+	LocalRepeated
+	// LocalSkipped means the wall clock time never occurred, typically
+	// because clocks were turned forward at the start of daylight saving time.
+	LocalSkipped
+)
+
+// String implements the fmt.Stringer interface.
+func (k LocalResultKind) String() string {
+	switch k {
+	case LocalSingle:
+		return "single"
+	case LocalRepeated:
+		return "repeated"
+	case LocalSkipped:
+		return "skipped"
+	}
+	return fmt.Sprintf("LocalResultKind(%d)", int(k))
+}
+
+// LocalResult is the result of binding a LocalDateTime to the timezone T.
+//
+// A wall clock time usually corresponds to a single instant, but it may
+// correspond to two instants or none at all around daylight saving time
+// transitions. LocalResult makes these cases explicit.
+type LocalResult[T TimeZone] struct {
+	local    LocalDateTime
+	kind     LocalResultKind
+	earliest Time[T]
+	latest   Time[T]
+}
+
+// LocalIn binds the wall clock time l to the timezone T.
+//
+// For example, in the United States, March 12, 2023 2:30am never occurred
+// (LocalSkipped), while November 5, 2023 1:30am occurred twice (LocalRepeated).
+func LocalIn[T TimeZone](l LocalDateTime) LocalResult[T] {
+	var tz T
+	earliest, latest, n := localInstants(tz.Location(), l.UTC())
+	kind := LocalSingle
+	switch n {
+	case 0:
+		kind = LocalSkipped
+	case 2:
+		kind = LocalRepeated
+	}
+	return LocalResult[T]{
+		local:    LocalDateTimeOf(l.UTC()),
+		kind:     kind,
+		earliest: In[T](earliest),
+		latest:   In[T](latest),
+	}
+}
+
+// Kind returns how the wall clock time maps to instants.
+func (r LocalResult[T]) Kind() LocalResultKind { return r.kind }
+
+// Single returns the instant if the wall clock time maps to exactly one instant.
+// Otherwise, ok is false.
+func (r LocalResult[T]) Single() (t Time[T], ok bool) {
+	if r.kind != LocalSingle {
+		return Time[T]{}, false
+	}
+	return r.earliest, true
+}
+
+// Earliest returns the earlier instant if the wall clock time was repeated,
+// or the only instant if it maps to exactly one instant.
+// If the wall clock time was skipped, ok is false.
+func (r LocalResult[T]) Earliest() (t Time[T], ok bool) {
+	if r.kind == LocalSkipped {
+		return Time[T]{}, false
+	}
+	return r.earliest, true
+}
+
+// Latest returns the later instant if the wall clock time was repeated,
+// or the only instant if it maps to exactly one instant.
+// If the wall clock time was skipped, ok is false.
+func (r LocalResult[T]) Latest() (t Time[T], ok bool) {
+	if r.kind == LocalSkipped {
+		return Time[T]{}, false
+	}
+	return r.latest, true
+}
+
+// localInstants returns the instants in loc whose wall clock reads the same
+// as civil. The civil argument must be in UTC and is interpreted as a wall clock.
+//
+// n reports how many instants were found: 1 for an ordinary wall clock, 2 when
+// the wall clock was repeated (e.g., at the end of DST) and 0 when it was skipped
+// (e.g., at the start of DST). For a skipped wall clock, earliest and latest are
+// the instants obtained by interpreting civil with the offset in effect after
+// and before the transition respectively.
+func localInstants(loc *time.Location, civil time.Time) (earliest, latest time.Time, n int) {
+	// Zone offsets are within ±14 hours, so looking up the offsets one day before
+	// and after covers any transition that may affect civil.
+	_, before := civil.Add(-24 * time.Hour).In(loc).Zone()
+	_, after := civil.Add(24 * time.Hour).In(loc).Zone()
+	if before == after {
+		tm := civil.Add(-time.Duration(before) * time.Second).In(loc)
+		return tm, tm, 1
+	}
+	withBefore := civil.Add(-time.Duration(before) * time.Second).In(loc)
+	withAfter := civil.Add(-time.Duration(after) * time.Second).In(loc)
+	_, off1 := withBefore.Zone()
+	_, off2 := withAfter.Zone()
+	valid1, valid2 := off1 == before, off2 == after
+	switch {
+	case valid1 && valid2:
+		n = 2
+	case valid1:
+		return withBefore, withBefore, 1
+	case valid2:
+		return withAfter, withAfter, 1
+	}
+	if withAfter.Before(withBefore) {
+		return withAfter, withBefore, n
+	}
+	return withBefore, withAfter, n
+}
@@ -0,0 +1,165 @@
+package synchro_test
+
+import (
+	"encoding"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/Code-Hex/synchro"
+	"github.com/Code-Hex/synchro/tz"
+	"github.com/google/go-cmp/cmp"
+)
+
+var _ interface {
+	fmt.Stringer
+	encoding.TextMarshaler
+	encoding.TextUnmarshaler
+} = (*synchro.LocalDateTime)(nil)
+
+func TestParseLocalDateTime(t *testing.T) {
+	tests := []struct {
+		value   string
+		want    synchro.LocalDateTime
+		wantErr bool
+	}{
+		{
+			value: "2023-09-02T09:00",
+			want:  synchro.NewLocalDateTime(2023, 9, 2, 9, 0, 0, 0),
+		},
+		{
+			value: "20230902T090000.5",
+			want:  synchro.NewLocalDateTime(2023, 9, 2, 9, 0, 0, 500000000),
+		},
+		{
+			value: "2023-09-02",
+			want:  synchro.NewLocalDateTime(2023, 9, 2, 0, 0, 0, 0),
+		},
+		{
+			value: "2023-W35-6T09:00",
+			want:  synchro.NewLocalDateTime(2023, 9, 2, 9, 0, 0, 0),
+		},
+		{
+			value:   "2023-09-02T09:00Z",
+			wantErr: true,
+		},
+		{
+			value:   "2023-09-02T09:00+09:00",
+			wantErr: true,
+		},
+		{
+			value:   "2023-09-02T09:00-05",
+			wantErr: true,
+		},
+		{
+			value:   "2023-09-02T",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			got, err := synchro.ParseLocalDateTime(tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("want error %v but got %v", tt.wantErr, err)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("(-want, +got)\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLocalDateTime_Text(t *testing.T) {
+	want := synchro.NewLocalDateTime(2023, 9, 2, 9, 30, 0, 0)
+	b, err := want.MarshalText()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s := "2023-09-02T09:30:00"; string(b) != s {
+		t.Fatalf("want %s but got %s", s, b)
+	}
+	var got synchro.LocalDateTime
+	if err := got.UnmarshalText(b); err != nil {
+		t.Fatal(err)
+	}
+	if got != want {
+		t.Errorf("want %s but got %s", want, got)
+	}
+}
+
+func TestLocalIn(t *testing.T) {
+	tests := []struct {
+		name     string
+		local    synchro.LocalDateTime
+		kind     synchro.LocalResultKind
+		earliest string
+		latest   string
+	}{
+		{
+			name:     "single",
+			local:    synchro.NewLocalDateTime(2023, 3, 12, 1, 30, 0, 0),
+			kind:     synchro.LocalSingle,
+			earliest: "2023-03-12T01:30:00-05:00",
+			latest:   "2023-03-12T01:30:00-05:00",
+		},
+		{
+			name:  "skipped",
+			local: synchro.NewLocalDateTime(2023, 3, 12, 2, 30, 0, 0),
+			kind:  synchro.LocalSkipped,
+		},
+		{
+			name:     "repeated",
+			local:    synchro.NewLocalDateTime(2023, 11, 5, 1, 30, 0, 0),
+			kind:     synchro.LocalRepeated,
+			earliest: "2023-11-05T01:30:00-04:00",
+			latest:   "2023-11-05T01:30:00-05:00",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := synchro.LocalIn[tz.AmericaNew_York](tt.local)
+			if r.Kind() != tt.kind {
+				t.Fatalf("want %s but got %s", tt.kind, r.Kind())
+			}
+			single, ok := r.Single()
+			if want := tt.kind == synchro.LocalSingle; ok != want {
+				t.Errorf("want single ok %v but got %v", want, ok)
+			}
+			if ok && single.Format(time.RFC3339) != tt.earliest {
+				t.Errorf("want single %s but got %s", tt.earliest, single.Format(time.RFC3339))
+			}
+			earliest, ok := r.Earliest()
+			if want := tt.kind != synchro.LocalSkipped; ok != want {
+				t.Errorf("want earliest ok %v but got %v", want, ok)
+			}
+			if ok && earliest.Format(time.RFC3339) != tt.earliest {
+				t.Errorf("want earliest %s but got %s", tt.earliest, earliest.Format(time.RFC3339))
+			}
+			latest, ok := r.Latest()
+			if want := tt.kind != synchro.LocalSkipped; ok != want {
+				t.Errorf("want latest ok %v but got %v", want, ok)
+			}
+			if ok && latest.Format(time.RFC3339) != tt.latest {
+				t.Errorf("want latest %s but got %s", tt.latest, latest.Format(time.RFC3339))
+			}
+		})
+	}
+}
+
+func ExampleLocalIn() {
+	// Every day at 09:00 wall clock.
+	local, _ := synchro.ParseLocalDateTime("2023-09-02T09:00")
+
+	utc, _ := synchro.LocalIn[tz.UTC](local).Single()
+	jst, _ := synchro.LocalIn[tz.AsiaTokyo](local).Single()
+	fmt.Println(utc)
+	fmt.Println(jst)
+
+	// 2:30am never occurred in New York on 2023-03-12.
+	skipped := synchro.NewLocalDateTime(2023, 3, 12, 2, 30, 0, 0)
+	fmt.Println(synchro.LocalIn[tz.AmericaNew_York](skipped).Kind())
+	// Output:
+	// 2023-09-02 09:00:00 +0000 UTC
+	// 2023-09-02 09:00:00 +0900 JST
+	// skipped
+}
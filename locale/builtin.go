@@ -0,0 +1,182 @@
+package locale
+
+// English is the locale data for "en".
+var English = &Locale{
+	Tag: "en",
+	PluralRule: func(n int) Plural {
+		if n == 1 {
+			return One
+		}
+		return Other
+	},
+	Now: "now",
+	Relative: map[Style]map[Unit]RelativePattern{
+		Long: {
+			Second: relative("in {0} second", "in {0} seconds", "{0} second ago", "{0} seconds ago"),
+			Minute: relative("in {0} minute", "in {0} minutes", "{0} minute ago", "{0} minutes ago"),
+			Hour:   relative("in {0} hour", "in {0} hours", "{0} hour ago", "{0} hours ago"),
+			Day:    relative("in {0} day", "in {0} days", "{0} day ago", "{0} days ago"),
+			Week:   relative("in {0} week", "in {0} weeks", "{0} week ago", "{0} weeks ago"),
+			Month:  relative("in {0} month", "in {0} months", "{0} month ago", "{0} months ago"),
+			Year:   relative("in {0} year", "in {0} years", "{0} year ago", "{0} years ago"),
+		},
+		Short: {
+			Second: relative("", "in {0} sec.", "", "{0} sec. ago"),
+			Minute: relative("", "in {0} min.", "", "{0} min. ago"),
+			Hour:   relative("", "in {0} hr.", "", "{0} hr. ago"),
+			Day:    relative("in {0} day", "in {0} days", "{0} day ago", "{0} days ago"),
+			Week:   relative("", "in {0} wk.", "", "{0} wk. ago"),
+			Month:  relative("", "in {0} mo.", "", "{0} mo. ago"),
+			Year:   relative("", "in {0} yr.", "", "{0} yr. ago"),
+		},
+		Narrow: {
+			Second: relative("", "in {0}s", "", "{0}s ago"),
+			Minute: relative("", "in {0}m", "", "{0}m ago"),
+			Hour:   relative("", "in {0}h", "", "{0}h ago"),
+			Day:    relative("", "in {0}d", "", "{0}d ago"),
+			Week:   relative("", "in {0}w", "", "{0}w ago"),
+			Month:  relative("", "in {0}mo", "", "{0}mo ago"),
+			Year:   relative("", "in {0}y", "", "{0}y ago"),
+		},
+	},
+	Months: [12]string{
+		"January", "February", "March", "April", "May", "June",
+		"July", "August", "September", "October", "November", "December",
+	},
+	ShortMonths: [12]string{
+		"Jan", "Feb", "Mar", "Apr", "May", "Jun",
+		"Jul", "Aug", "Sep", "Oct", "Nov", "Dec",
+	},
+	Weekdays: [7]string{
+		"Sunday", "Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday",
+	},
+	ShortWeekdays: [7]string{
+		"Sun", "Mon", "Tue", "Wed", "Thu", "Fri", "Sat",
+	},
+	AM: "AM",
+	PM: "PM",
+}
+
+// Japanese is the locale data for "ja".
+var Japanese = &Locale{
+	Tag:        "ja",
+	PluralRule: func(int) Plural { return Other },
+	Now:        "今",
+	Relative: map[Style]map[Unit]RelativePattern{
+		Long: {
+			Second: suffixed("{0} 秒%s", "後", "前"),
+			Minute: suffixed("{0} 分%s", "後", "前"),
+			Hour:   suffixed("{0} 時間%s", "後", "前"),
+			Day:    suffixed("{0} 日%s", "後", "前"),
+			Week:   suffixed("{0} 週間%s", "後", "前"),
+			Month:  suffixed("{0} か月%s", "後", "前"),
+			Year:   suffixed("{0} 年%s", "後", "前"),
+		},
+		Narrow: {
+			Second: suffixed("{0}秒%s", "後", "前"),
+			Minute: suffixed("{0}分%s", "後", "前"),
+			Hour:   suffixed("{0}時間%s", "後", "前"),
+			Day:    suffixed("{0}日%s", "後", "前"),
+			Week:   suffixed("{0}週間%s", "後", "前"),
+			Month:  suffixed("{0}か月%s", "後", "前"),
+			Year:   suffixed("{0}年%s", "後", "前"),
+		},
+	},
+	Months: [12]string{
+		"1月", "2月", "3月", "4月", "5月", "6月",
+		"7月", "8月", "9月", "10月", "11月", "12月",
+	},
+	ShortMonths: [12]string{
+		"1月", "2月", "3月", "4月", "5月", "6月",
+		"7月", "8月", "9月", "10月", "11月", "12月",
+	},
+	Weekdays: [7]string{
+		"日曜日", "月曜日", "火曜日", "水曜日", "木曜日", "金曜日", "土曜日",
+	},
+	ShortWeekdays: [7]string{
+		"日", "月", "火", "水", "木", "金", "土",
+	},
+	AM: "午前",
+	PM: "午後",
+}
+
+// German is the locale data for "de". Relative time falls back to English.
+var German = &Locale{
+	Tag: "de",
+	PluralRule: func(n int) Plural {
+		if n == 1 {
+			return One
+		}
+		return Other
+	},
+	Months: [12]string{
+		"Januar", "Februar", "März", "April", "Mai", "Juni",
+		"Juli", "August", "September", "Oktober", "November", "Dezember",
+	},
+	ShortMonths: [12]string{
+		"Jan", "Feb", "Mär", "Apr", "Mai", "Jun",
+		"Jul", "Aug", "Sep", "Okt", "Nov", "Dez",
+	},
+	Weekdays: [7]string{
+		"Sonntag", "Montag", "Dienstag", "Mittwoch", "Donnerstag", "Freitag", "Samstag",
+	},
+	ShortWeekdays: [7]string{
+		"So", "Mo", "Di", "Mi", "Do", "Fr", "Sa",
+	},
+	AM: "AM",
+	PM: "PM",
+}
+
+// French is the locale data for "fr". Relative time falls back to English.
+var French = &Locale{
+	Tag: "fr",
+	PluralRule: func(n int) Plural {
+		if n == 0 || n == 1 {
+			return One
+		}
+		return Other
+	},
+	Months: [12]string{
+		"janvier", "février", "mars", "avril", "mai", "juin",
+		"juillet", "août", "septembre", "octobre", "novembre", "décembre",
+	},
+	ShortMonths: [12]string{
+		"janv.", "févr.", "mars", "avr.", "mai", "juin",
+		"juil.", "août", "sept.", "oct.", "nov.", "déc.",
+	},
+	Weekdays: [7]string{
+		"dimanche", "lundi", "mardi", "mercredi", "jeudi", "vendredi", "samedi",
+	},
+	ShortWeekdays: [7]string{
+		"dim.", "lun.", "mar.", "mer.", "jeu.", "ven.", "sam.",
+	},
+	AM: "AM",
+	PM: "PM",
+}
+
+// Spanish is the locale data for "es". Relative time falls back to English.
+var Spanish = &Locale{
+	Tag: "es",
+	PluralRule: func(n int) Plural {
+		if n == 1 {
+			return One
+		}
+		return Other
+	},
+	Months: [12]string{
+		"enero", "febrero", "marzo", "abril", "mayo", "junio",
+		"julio", "agosto", "septiembre", "octubre", "noviembre", "diciembre",
+	},
+	ShortMonths: [12]string{
+		"ene", "feb", "mar", "abr", "may", "jun",
+		"jul", "ago", "sept", "oct", "nov", "dic",
+	},
+	Weekdays: [7]string{
+		"domingo", "lunes", "martes", "miércoles", "jueves", "viernes", "sábado",
+	},
+	ShortWeekdays: [7]string{
+		"dom", "lun", "mar", "mié", "jue", "vie", "sáb",
+	},
+	AM: "a. m.",
+	PM: "p. m.",
+}
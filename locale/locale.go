@@ -0,0 +1,209 @@
+// Package locale provides locale data used by synchro to format and parse
+// human-readable date and time.
+//
+// A Locale is plain data, so a locale which is not built in can be defined by
+// the user. Entries which are missing in a Locale fall back to English.
+package locale
+
+import (
+	"strings"
+	"time"
+)
+
+// Plural is a plural category of CLDR.
+type Plural int
+
+const (
+	Other Plural = iota
+	Zero
+	One
+	Two
+	Few
+	Many
+)
+
+// Style is the width of formatted text.
+type Style int
+
+const (
+	// Long is the style such as "3 hours ago".
+	Long Style = iota
+	// Short is the style such as "3 hr. ago".
+	Short
+	// Narrow is the style such as "3h ago".
+	Narrow
+)
+
+// Unit is a unit of relative time.
+type Unit int
+
+const (
+	Second Unit = iota
+	Minute
+	Hour
+	Day
+	Week
+	Month
+	Year
+)
+
+// RelativePattern holds patterns of relative time for each plural category.
+// "{0}" in a pattern is replaced with the number.
+type RelativePattern struct {
+	Past   map[Plural]string
+	Future map[Plural]string
+}
+
+// Locale is locale data.
+type Locale struct {
+	// Tag is the BCP 47 language tag such as "en".
+	Tag string
+
+	// PluralRule returns the plural category of the cardinal number n.
+	PluralRule func(n int) Plural
+
+	// Now is the text for the relative time which is the same as now.
+	Now string
+
+	// Relative holds the patterns of relative time for each style and unit.
+	Relative map[Style]map[Unit]RelativePattern
+
+	// Months holds the month names from January, and ShortMonths holds the
+	// abbreviated ones.
+	Months      [12]string
+	ShortMonths [12]string
+
+	// Weekdays holds the weekday names from Sunday, and ShortWeekdays holds
+	// the abbreviated ones.
+	Weekdays      [7]string
+	ShortWeekdays [7]string
+
+	// AM and PM are the names of the day periods.
+	AM, PM string
+}
+
+// RelativePattern returns the pattern of relative time. The pattern falls
+// back to Long style, then to English.
+func (l *Locale) RelativePattern(style Style, unit Unit, n int, past bool) string {
+	for _, loc := range []*Locale{l, English} {
+		if loc == nil {
+			continue
+		}
+		for _, s := range []Style{style, Long} {
+			p, ok := loc.Relative[s][unit]
+			if !ok {
+				continue
+			}
+			patterns := p.Future
+			if past {
+				patterns = p.Past
+			}
+			if v, ok := patterns[loc.plural(n)]; ok {
+				return v
+			}
+			if v, ok := patterns[Other]; ok {
+				return v
+			}
+		}
+	}
+	return "{0}"
+}
+
+// NowText returns the text for now. It falls back to English.
+func (l *Locale) NowText() string {
+	if l != nil && l.Now != "" {
+		return l.Now
+	}
+	return English.Now
+}
+
+// MonthName returns the name of the month m, or the abbreviated name if abbr
+// is true. It falls back to English. If m is out of range, it returns the
+// string of m such as "%!Month(13)" as time.Month does.
+func (l *Locale) MonthName(m time.Month, abbr bool) string {
+	if m < time.January || m > time.December {
+		return m.String()
+	}
+	for _, loc := range []*Locale{l, English} {
+		if loc == nil {
+			continue
+		}
+		names := loc.Months
+		if abbr {
+			names = loc.ShortMonths
+		}
+		if v := names[m-1]; v != "" {
+			return v
+		}
+	}
+	return ""
+}
+
+// WeekdayName returns the name of the weekday d, or the abbreviated name if
+// abbr is true. It falls back to English. If d is out of range, it returns
+// the string of d such as "%!Weekday(7)" as time.Weekday does.
+func (l *Locale) WeekdayName(d time.Weekday, abbr bool) string {
+	if d < time.Sunday || d > time.Saturday {
+		return d.String()
+	}
+	for _, loc := range []*Locale{l, English} {
+		if loc == nil {
+			continue
+		}
+		names := loc.Weekdays
+		if abbr {
+			names = loc.ShortWeekdays
+		}
+		if v := names[d]; v != "" {
+			return v
+		}
+	}
+	return ""
+}
+
+// DayPeriod returns the name of the day period, AM or PM. It falls back to
+// English.
+func (l *Locale) DayPeriod(pm bool) string {
+	for _, loc := range []*Locale{l, English} {
+		if loc == nil {
+			continue
+		}
+		v := loc.AM
+		if pm {
+			v = loc.PM
+		}
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
+
+func (l *Locale) plural(n int) Plural {
+	if l.PluralRule == nil {
+		return Other
+	}
+	return l.PluralRule(n)
+}
+
+// relative builds a RelativePattern from the future and past patterns for
+// One and Other. Empty one means the same as other.
+func relative(futureOne, futureOther, pastOne, pastOther string) RelativePattern {
+	p := RelativePattern{
+		Future: map[Plural]string{Other: futureOther},
+		Past:   map[Plural]string{Other: pastOther},
+	}
+	if futureOne != "" {
+		p.Future[One] = futureOne
+	}
+	if pastOne != "" {
+		p.Past[One] = pastOne
+	}
+	return p
+}
+
+// suffixed builds a RelativePattern which has no plural forms from the pattern
+// by replacing "%s" with future and past.
+func suffixed(pattern, future, past string) RelativePattern {
+	return relative("", strings.Replace(pattern, "%s", future, 1), "", strings.Replace(pattern, "%s", past, 1))
+}
@@ -0,0 +1,76 @@
+package locale
+
+import (
+	"testing"
+	"time"
+)
+
+func TestLocale_RelativePattern(t *testing.T) {
+	custom := &Locale{
+		Tag: "xx",
+		Relative: map[Style]map[Unit]RelativePattern{
+			Long: {Hour: relative("", "+{0}h", "", "-{0}h")},
+		},
+	}
+	tests := []struct {
+		name   string
+		locale *Locale
+		style  Style
+		unit   Unit
+		n      int
+		past   bool
+		want   string
+	}{
+		{name: "en one", locale: English, unit: Hour, n: 1, want: "in {0} hour"},
+		{name: "en other", locale: English, unit: Hour, n: 2, past: true, want: "{0} hours ago"},
+		{name: "en short one falls back to other", locale: English, style: Short, unit: Hour, n: 1, want: "in {0} hr."},
+		{name: "ja", locale: Japanese, unit: Day, n: 1, past: true, want: "{0} 日前"},
+		{name: "custom", locale: custom, unit: Hour, n: 1, past: true, want: "-{0}h"},
+		{name: "custom falls back to en", locale: custom, unit: Day, n: 2, want: "in {0} days"},
+		{name: "nil is en", unit: Minute, n: 1, past: true, want: "{0} minute ago"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.locale.RelativePattern(tt.style, tt.unit, tt.n, tt.past); got != tt.want {
+				t.Errorf("want %q but got %q", tt.want, got)
+			}
+		})
+	}
+	if got := custom.NowText(); got != "now" {
+		t.Errorf("want now but got %q", got)
+	}
+}
+
+func TestLocale_Names(t *testing.T) {
+	custom := &Locale{Tag: "xx", Months: [12]string{8: "nine"}}
+	tests := []struct {
+		name string
+		got  string
+		want string
+	}{
+		{name: "en month", got: English.MonthName(time.September, false), want: "September"},
+		{name: "en short month", got: English.MonthName(time.September, true), want: "Sep"},
+		{name: "ja month", got: Japanese.MonthName(time.September, false), want: "9月"},
+		{name: "ja weekday", got: Japanese.WeekdayName(time.Monday, false), want: "月曜日"},
+		{name: "ja short weekday", got: Japanese.WeekdayName(time.Monday, true), want: "月"},
+		{name: "de month", got: German.MonthName(time.March, false), want: "März"},
+		{name: "fr short weekday", got: French.WeekdayName(time.Sunday, true), want: "dim."},
+		{name: "es pm", got: Spanish.DayPeriod(true), want: "p. m."},
+		{name: "ja am", got: Japanese.DayPeriod(false), want: "午前"},
+		{name: "custom", got: custom.MonthName(time.September, false), want: "nine"},
+		{name: "custom falls back to en", got: custom.MonthName(time.October, false), want: "October"},
+		{name: "custom short falls back to en", got: custom.MonthName(time.September, true), want: "Sep"},
+		{name: "nil is en", got: (*Locale)(nil).WeekdayName(time.Friday, false), want: "Friday"},
+		{name: "month out of range", got: Japanese.MonthName(13, false), want: "%!Month(13)"},
+		{name: "month zero", got: Japanese.MonthName(0, true), want: "%!Month(0)"},
+		{name: "weekday out of range", got: Japanese.WeekdayName(7, false), want: "%!Weekday(7)"},
+		{name: "negative weekday", got: Japanese.WeekdayName(-1, true), want: time.Weekday(-1).String()},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("want %q but got %q", tt.want, tt.got)
+			}
+		})
+	}
+}
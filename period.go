@@ -1,7 +1,11 @@
 package synchro
 
 import (
+	"cmp"
 	"fmt"
+	"iter"
+	"math"
+	"strings"
 	"time"
 	"unsafe"
 
@@ -15,58 +19,512 @@ type timeish[T TimeZone] interface {
 
 // Period allows iteration over a set of dates and times,
 // recurring at regular intervals, over a given period.
+//
+// The bounds of a period determine whether from and to are included in the
+// period. The zero value includes both of them.
+//
+// A period may be unbounded: it has no from (negative infinity) or no to
+// (positive infinity). An unbounded side is always excluded from the bounds.
 type Period[T TimeZone] struct {
-	from Time[T]
-	to   Time[T]
+	from          Time[T]
+	to            Time[T]
+	bounds        Bounds
+	fromUnbounded bool
+	toUnbounded   bool
 }
 
 // String implements the fmt.Stringer interface.
+//
+// The bounds are appended if either of from and to is excluded. An unbounded
+// side is written as "-infinity" or "infinity".
 func (p Period[T]) String() string {
-	return fmt.Sprintf("from %s to %s", p.from, p.to)
+	from, to := p.from.String(), p.to.String()
+	if p.fromUnbounded {
+		from = "-infinity"
+	}
+	if p.toUnbounded {
+		to = "infinity"
+	}
+	if p.bounds != BoundsClosed {
+		return fmt.Sprintf("from %s to %s %s", from, to, p.bounds)
+	}
+	return fmt.Sprintf("from %s to %s", from, to)
 }
 
 // From returns end of period.
+//
+// It returns the zero value if the period has no from.
 func (p Period[T]) From() Time[T] { return p.from }
 
 // To returns start of period.
+//
+// It returns the zero value if the period has no to.
 func (p Period[T]) To() Time[T] { return p.to }
 
+// MarshalText implements the encoding.TextMarshaler interface.
+//
+// The period is written in the ISO 8601 "start/end" notation, and an unbounded
+// side is written as ".." as the ISO 8601-2 open interval notation. If from or
+// to is excluded, the bounds are written around it such as "[start/end)".
+// Otherwise it can be parsed by iso8601.ParseInterval.
+func (p Period[T]) MarshalText() ([]byte, error) {
+	// An unbounded side is always excluded, so the bounds are needed only if
+	// a time is excluded.
+	bracket := !p.fromUnbounded && !p.bounds.IncludesFrom() ||
+		!p.toUnbounded && !p.bounds.IncludesTo()
+	var b []byte
+	if bracket {
+		b = append(b, p.bounds.String()[0])
+	}
+	b, err := appendPeriodSide(b, p.from, p.fromUnbounded)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, '/')
+	b, err = appendPeriodSide(b, p.to, p.toUnbounded)
+	if err != nil {
+		return nil, err
+	}
+	if bracket {
+		b = append(b, p.bounds.String()[1])
+	}
+	return b, nil
+}
+
+func appendPeriodSide[T TimeZone](b []byte, t Time[T], unbounded bool) ([]byte, error) {
+	if unbounded {
+		return append(b, ".."...), nil
+	}
+	text, err := t.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+	return append(b, text...), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+//
+// The period is expected to be in the format written by MarshalText. Each side
+// is ".." or a string in a format accepted by ParseISO.
+func (p *Period[T]) UnmarshalText(data []byte) error {
+	s := string(data)
+	bounds := BoundsClosed
+	if len(s) > 0 && (s[0] == '[' || s[0] == '(') {
+		b, err := ParseBounds(s[:1] + s[len(s)-1:])
+		if err != nil {
+			return fmt.Errorf("synchro: Period.UnmarshalText: invalid bounds: %q", s)
+		}
+		bounds, s = b, s[1:len(s)-1]
+	}
+	from, to, ok := strings.Cut(s, "/")
+	if !ok {
+		return fmt.Errorf("synchro: Period.UnmarshalText: missing \"/\": %q", data)
+	}
+	period, err := NewPeriod[T](from, to)
+	if err != nil {
+		return fmt.Errorf("synchro: Period.UnmarshalText: %w", err)
+	}
+	*p = period.WithBounds(bounds)
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// The period is a quoted string in the format written by MarshalText.
+func (p Period[T]) MarshalJSON() ([]byte, error) {
+	text, err := p.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+	b := make([]byte, 0, len(text)+2)
+	b = append(b, '"')
+	b = append(b, text...)
+	b = append(b, '"')
+	return b, nil
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// The period must be a quoted string in the format accepted by UnmarshalText.
+func (p *Period[T]) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	if len(data) < 2 || data[0] != '"' || data[len(data)-1] != '"' {
+		return fmt.Errorf("synchro: Period.UnmarshalJSON: input is not a JSON string: %s", data)
+	}
+	return p.UnmarshalText(data[1 : len(data)-1])
+}
+
+// IsFromUnbounded reports whether the period has no from, which means it
+// starts at negative infinity.
+func (p Period[T]) IsFromUnbounded() bool { return p.fromUnbounded }
+
+// IsToUnbounded reports whether the period has no to, which means it ends at
+// positive infinity.
+func (p Period[T]) IsToUnbounded() bool { return p.toUnbounded }
+
 // NewPeriod creates a new Period struct between the 'from' and 'to' values you specified.
 //
 // If Time[T] or time.Time is specified, it guarantees no error returns.
 // When a string or []byte is passed, ParseISO function is called internally. Therefore, these
 // parameters should be in a format compatible with ParseISO.
+//
+// The string ".." makes the side unbounded as the ISO 8601-2 open interval notation.
+// Either of from and to can be a duration in ISO 8601 format such as "P1M", which
+// makes the period in the "start/duration" or "duration/end" notation of ISO 8601
+// intervals.
 func NewPeriod[T TimeZone, T1 timeish[T], T2 timeish[T]](from T1, to T2) (Period[T], error) {
-	start, err := convertTime[T, T1](unsafe.Pointer(&from))
+	start, err := parsePeriodSide[T, T1](unsafe.Pointer(&from))
 	if err != nil {
 		return Period[T]{}, fmt.Errorf("failed to parse from: %w", err)
 	}
-	end, err := convertTime[T, T2](unsafe.Pointer(&to))
+	end, err := parsePeriodSide[T, T2](unsafe.Pointer(&to))
 	if err != nil {
 		return Period[T]{}, fmt.Errorf("failed to parse to: %w", err)
 	}
-	return Period[T]{
-		from: start,
-		to:   end,
+	switch {
+	case start.duration != nil && end.duration != nil:
+		return Period[T]{}, fmt.Errorf("both from and to are durations")
+	case start.duration != nil && end.unbounded, end.duration != nil && start.unbounded:
+		return Period[T]{}, fmt.Errorf("duration with unbounded side")
+	case start.duration != nil:
+		start.t = In[T](start.duration.SubFrom(end.t.StdTime()))
+	case end.duration != nil:
+		end.t = start.t.AddISODuration(*end.duration)
+	}
+	p := Period[T]{
+		from:          start.t,
+		to:            end.t,
+		fromUnbounded: start.unbounded,
+		toUnbounded:   end.unbounded,
+	}
+	return p.WithBounds(BoundsClosed), nil
+}
+
+// PeriodFromInterval converts the ISO 8601 interval to Period. The from and to
+// are the start and the end of the interval, which are included. If the
+// interval repeats, the period is the first occurrence; use PeriodsFromInterval
+// to iterate over all of them.
+//
+// It returns an error if the interval has only a duration.
+func PeriodFromInterval[T TimeZone](i iso8601.Interval) (Period[T], error) {
+	if !i.StartUnbounded() && !i.EndUnbounded() && i.Start().IsZero() && i.End().IsZero() {
+		return Period[T]{}, fmt.Errorf("interval has neither start nor end: %v", i.Duration())
+	}
+	p := Period[T]{
+		fromUnbounded: i.StartUnbounded(),
+		toUnbounded:   i.EndUnbounded(),
+	}
+	if !p.fromUnbounded {
+		p.from = In[T](i.Start())
+	}
+	if !p.toUnbounded {
+		p.to = In[T](i.End())
+	}
+	return p.WithBounds(BoundsClosed), nil
+}
+
+// PeriodsFromInterval returns an iterator over the occurrences of the repeating
+// ISO 8601 interval such as "R5/2023-01-01T00:00:00Z/P1D". The periods are the
+// same as iso8601.Interval.Occurrences: the iteration stops after the number of
+// the repeat, or continues until the loop is broken if the interval repeats
+// infinitely. An interval which does not repeat has one occurrence.
+//
+// It returns an error if the interval has only a duration, or it is unbounded
+// and repeats.
+func PeriodsFromInterval[T TimeZone](i iso8601.Interval) (iter.Seq[Period[T]], error) {
+	p, err := PeriodFromInterval[T](i)
+	if err != nil {
+		return nil, err
+	}
+	if i.Repeat() != 0 && (p.fromUnbounded || p.toUnbounded) {
+		return nil, fmt.Errorf("unbounded interval cannot be repeated")
+	}
+	return func(yield func(Period[T]) bool) {
+		for o := range i.Occurrences() {
+			// The occurrences always have the start and the end.
+			p, _ := PeriodFromInterval[T](o)
+			if !yield(p) {
+				return
+			}
+		}
 	}, nil
 }
 
+// ISOInterval converts p to the ISO 8601 interval. The start and the end are
+// in chronological order, even if from is after to. The bounds are not kept as
+// ISO 8601 intervals include both the start and the end.
+func (p Period[T]) ISOInterval() iso8601.Interval {
+	lo, hi := p.lower(), p.upper()
+	i := iso8601.NewInterval(lo.t.StdTime(), hi.t.StdTime())
+	if lo.inf != 0 {
+		i = i.WithStartUnbounded()
+	}
+	if hi.inf != 0 {
+		i = i.WithEndUnbounded()
+	}
+	return i
+}
+
+// PeriodSince creates a new Period which starts at from and has no end.
+// from is included.
+func PeriodSince[T TimeZone](from Time[T]) Period[T] {
+	return Period[T]{from: from, toUnbounded: true, bounds: BoundsClosedOpen}
+}
+
+// PeriodUntil creates a new Period which has no start and ends at to.
+// to is included.
+func PeriodUntil[T TimeZone](to Time[T]) Period[T] {
+	return Period[T]{to: to, fromUnbounded: true, bounds: BoundsOpenClosed}
+}
+
 // Contains checks whether the specified t is included within from and to.
 //
-// if p.from < t && t < p.to, it returns +1; if p.from == t || t == p.to, it returns 0.
-// Otherwise returns -1;
+// If p.from < t && t < p.to, it returns +1; if t is the same as an endpoint
+// which is included in the period, it returns 0. Otherwise returns -1.
+// Unlike the other methods, from and to are not reordered, so no time is
+// between the endpoints of a reversed period.
 func (p Period[T]) Contains(t Time[T]) int {
-	cmpFrom := p.from.Compare(t)
-	cmpTo := p.to.Compare(t)
-	if cmpFrom == -1 && cmpTo == 1 {
+	lo, hi := p.lower(), p.upper()
+	if p.isReversed() {
+		lo, hi = hi, lo
+	}
+	cmpLo, cmpHi := lo.compareTime(t), hi.compareTime(t)
+	if cmpLo == -1 && cmpHi == 1 {
 		return 1
 	}
-	if cmpFrom == 0 || cmpTo == 0 {
+	if cmpLo == 0 && lo.incl || cmpHi == 0 && hi.incl {
 		return 0
 	}
 	return -1
 }
 
+// Bounds returns the bounds of the period.
+func (p Period[T]) Bounds() Bounds { return p.bounds }
+
+// WithBounds returns the period which has the same from and to as p, and
+// the bounds b. An unbounded side stays excluded whatever b is.
+func (p Period[T]) WithBounds(b Bounds) Period[T] {
+	p.bounds = boundsOf(
+		b.IncludesFrom() && !p.fromUnbounded,
+		b.IncludesTo() && !p.toUnbounded,
+	)
+	return p
+}
+
+// IsEmpty reports whether the period contains no time. It is the case if
+// from and to are the same and either of them is excluded.
+func (p Period[T]) IsEmpty() bool {
+	return isEmptyRange(p.lower(), p.upper())
+}
+
+// bound is an endpoint of a period. inf is -1 for negative infinity and +1 for
+// positive infinity, in which case t is not used.
+type bound[T TimeZone] struct {
+	t    Time[T]
+	incl bool
+	inf  int
+}
+
+// isReversed reports whether from is after to.
+func (p Period[T]) isReversed() bool {
+	return !p.fromUnbounded && !p.toUnbounded && p.from.After(p.to)
+}
+
+// lower returns the earlier endpoint of the period.
+func (p Period[T]) lower() bound[T] {
+	if p.isReversed() {
+		return bound[T]{t: p.to, incl: p.bounds.IncludesTo()}
+	}
+	if p.fromUnbounded {
+		return bound[T]{inf: -1}
+	}
+	return bound[T]{t: p.from, incl: p.bounds.IncludesFrom()}
+}
+
+// upper returns the later endpoint of the period.
+func (p Period[T]) upper() bound[T] {
+	if p.isReversed() {
+		return bound[T]{t: p.from, incl: p.bounds.IncludesFrom()}
+	}
+	if p.toUnbounded {
+		return bound[T]{inf: 1}
+	}
+	return bound[T]{t: p.to, incl: p.bounds.IncludesTo()}
+}
+
+// periodOf returns the period from lo to hi.
+func periodOf[T TimeZone](lo, hi bound[T]) Period[T] {
+	return Period[T]{
+		from:          lo.t,
+		to:            hi.t,
+		bounds:        boundsOf(lo.incl, hi.incl),
+		fromUnbounded: lo.inf < 0,
+		toUnbounded:   hi.inf > 0,
+	}
+}
+
+// compareTime compares the position of b with t.
+func (b bound[T]) compareTime(t Time[T]) int {
+	if b.inf != 0 {
+		return b.inf
+	}
+	return b.t.Compare(t)
+}
+
+// compareBound compares the positions of a and b, ignoring whether they are
+// included.
+func compareBound[T TimeZone](a, b bound[T]) int {
+	if a.inf != 0 || b.inf != 0 {
+		return cmp.Compare(a.inf, b.inf)
+	}
+	return a.t.Compare(b.t)
+}
+
+// compareLower compares lower bounds. The included one starts earlier.
+func compareLower[T TimeZone](a, b bound[T]) int {
+	if c := compareBound(a, b); c != 0 || a.incl == b.incl {
+		return c
+	}
+	if a.incl {
+		return -1
+	}
+	return 1
+}
+
+// compareUpper compares upper bounds. The included one ends later.
+func compareUpper[T TimeZone](a, b bound[T]) int {
+	if c := compareBound(a, b); c != 0 || a.incl == b.incl {
+		return c
+	}
+	if a.incl {
+		return 1
+	}
+	return -1
+}
+
+// isEmptyRange reports whether no time is between lo and hi.
+func isEmptyRange[T TimeZone](lo, hi bound[T]) bool {
+	c := compareBound(lo, hi)
+	return c > 0 || c == 0 && !(lo.incl && hi.incl)
+}
+
+// adjoins reports whether no time is between hi and lo, which is the upper
+// bound of a period and the lower bound of the next period.
+func adjoins[T TimeZone](hi, lo bound[T]) bool {
+	c := compareBound(hi, lo)
+	return c > 0 || c == 0 && (hi.incl || lo.incl)
+}
+
+// complement returns the bound which is the other side of b. An infinity is
+// never included.
+func (b bound[T]) complement() bound[T] {
+	return bound[T]{t: b.t, incl: !b.incl && b.inf == 0, inf: b.inf}
+}
+
+// Duration returns the length of the period. It is never negative, even if
+// from is after to. If the period is unbounded, it returns the maximum
+// time.Duration.
+func (p Period[T]) Duration() time.Duration {
+	if p.fromUnbounded || p.toUnbounded {
+		return math.MaxInt64
+	}
+	return p.upper().t.Sub(p.lower().t)
+}
+
+// Overlaps reports whether p and q have at least one time in common.
+//
+// The bounds of the periods are honored. For example, [a, b] overlaps [b, c]
+// at b, but [a, b) does not overlap [b, c).
+func (p Period[T]) Overlaps(q Period[T]) bool {
+	_, ok := p.Intersect(q)
+	return ok
+}
+
+// Abuts reports whether the end of one period is the start of the other, and
+// no time is between them. For example, [a, b) abuts [b, c), but (a, b) does
+// not abut (b, c) as b is in neither of them.
+func (p Period[T]) Abuts(q Period[T]) bool {
+	return compareBound(p.upper(), q.lower()) == 0 && adjoins(p.upper(), q.lower()) ||
+		compareBound(q.upper(), p.lower()) == 0 && adjoins(q.upper(), p.lower())
+}
+
+// Intersect returns the period which is contained in both p and q.
+// It returns false if p and q do not overlap.
+//
+// The periods returned by the set operations always have from not after to.
+func (p Period[T]) Intersect(q Period[T]) (Period[T], bool) {
+	lo, hi := p.lower(), p.upper()
+	if compareLower(q.lower(), lo) > 0 {
+		lo = q.lower()
+	}
+	if compareUpper(q.upper(), hi) < 0 {
+		hi = q.upper()
+	}
+	if isEmptyRange(lo, hi) {
+		return Period[T]{}, false
+	}
+	return periodOf(lo, hi), true
+}
+
+// Union returns the period which covers both p and q. It returns false if
+// there is a gap between p and q. Use PeriodSet to hold such periods.
+func (p Period[T]) Union(q Period[T]) (Period[T], bool) {
+	if p.IsEmpty() {
+		return q, !q.IsEmpty()
+	}
+	if q.IsEmpty() {
+		return p, true
+	}
+	if !adjoins(p.upper(), q.lower()) || !adjoins(q.upper(), p.lower()) {
+		return Period[T]{}, false
+	}
+	lo, hi := p.lower(), p.upper()
+	if compareLower(q.lower(), lo) < 0 {
+		lo = q.lower()
+	}
+	if compareUpper(q.upper(), hi) > 0 {
+		hi = q.upper()
+	}
+	return periodOf(lo, hi), true
+}
+
+// Subtract returns the parts of p which are not covered by q, in chronological
+// order. The result has zero, one or two periods.
+func (p Period[T]) Subtract(q Period[T]) []Period[T] {
+	if !p.Overlaps(q) {
+		if p.IsEmpty() {
+			return nil
+		}
+		return []Period[T]{periodOf(p.lower(), p.upper())}
+	}
+	// As p overlaps q, the start of q is not after the end of p, and the end
+	// of q is not before the start of p.
+	var ps []Period[T]
+	if hi := q.lower().complement(); !isEmptyRange(p.lower(), hi) {
+		ps = append(ps, periodOf(p.lower(), hi))
+	}
+	if lo := q.upper().complement(); !isEmptyRange(lo, p.upper()) {
+		ps = append(ps, periodOf(lo, p.upper()))
+	}
+	return ps
+}
+
+// Gap returns the period between p and q. It returns false if no time is
+// between p and q.
+func (p Period[T]) Gap(q Period[T]) (Period[T], bool) {
+	if p.Overlaps(q) || p.IsEmpty() || q.IsEmpty() {
+		return Period[T]{}, false
+	}
+	lo, hi := p.upper().complement(), q.lower().complement()
+	if compareUpper(q.upper(), p.upper()) < 0 {
+		lo, hi = q.upper().complement(), p.lower().complement()
+	}
+	if isEmptyRange(lo, hi) {
+		return Period[T]{}, false
+	}
+	return periodOf(lo, hi), true
+}
+
 type periodical[T TimeZone] <-chan Time[T]
 
 // Slice returns the slice of Time[T].
@@ -86,22 +544,75 @@ func (p periodical[T]) Slice() (s []Time[T]) {
 //
 // If start > end, the process will decrease from start to end. In other words,
 // when the current value falls below end, the iteration is terminated.
+//
+// Deprecated: The goroutine which sends values to the channel leaks if the
+// channel is not drained. Use PeriodicSeq instead.
 func (p Period[T]) Periodic(next func(Time[T]) Time[T]) periodical[T] {
-	compare := isNotAfter[T]
-	// p.start > p.end
-	if p.from.After(p.to) {
-		compare = isNotBefore[T]
-	}
 	ch := make(chan Time[T], 1)
 	go func() {
 		defer close(ch)
-		for current := p.from; compare(current, p.to); current = next(current) {
+		for current := range p.PeriodicSeq(next) {
 			ch <- current
 		}
 	}()
 	return ch
 }
 
+// PeriodicSeq returns an iterator over Time[T] values at regular intervals
+// between the start and end times of the Period[T]. The interval is specified
+// by the next function argument.
+//
+// The iteration is the same as Periodic, but it runs in the caller's goroutine
+// and can be stopped early by breaking out of the loop.
+//
+// The bounds of the period are honored: from is skipped if it is excluded,
+// and to is not emitted if it is excluded.
+//
+// If the period has no from, nothing is emitted as there is no time to start
+// from. If the period has no to, the iteration continues until the loop is
+// broken or next does not advance the time.
+func (p Period[T]) PeriodicSeq(next func(Time[T]) Time[T]) iter.Seq[Time[T]] {
+	if p.fromUnbounded {
+		return func(func(Time[T]) bool) {}
+	}
+	if p.toUnbounded {
+		return func(yield func(Time[T]) bool) {
+			current := p.from
+			if !p.bounds.IncludesFrom() {
+				current = next(current)
+			}
+			for yield(current) {
+				prev := current
+				if current = next(current); !current.After(prev) {
+					return
+				}
+			}
+		}
+	}
+	compare := isNotAfter[T]
+	if !p.bounds.IncludesTo() {
+		compare = Time[T].Before
+	}
+	// p.start > p.end
+	if p.from.After(p.to) {
+		compare = isNotBefore[T]
+		if !p.bounds.IncludesTo() {
+			compare = Time[T].After
+		}
+	}
+	return func(yield func(Time[T]) bool) {
+		current := p.from
+		if !p.bounds.IncludesFrom() {
+			current = next(current)
+		}
+		for ; compare(current, p.to); current = next(current) {
+			if !yield(current) {
+				return
+			}
+		}
+	}
+}
+
 func isNotAfter[T TimeZone](t1, t2 Time[T]) bool {
 	// t1.Compare(t2) <= 0
 	return !t1.After(t2)
@@ -114,26 +625,62 @@ func isNotBefore[T TimeZone](t1, t2 Time[T]) bool {
 
 // PeriodicDuration is a wrapper for the Periodic function.
 // The interval is specified by the time.Duration argument.
+//
+// Deprecated: Use PeriodicDurationSeq instead.
 func (p Period[T]) PeriodicDuration(d time.Duration) periodical[T] {
-	return p.Periodic(func(t Time[T]) Time[T] {
+	return p.Periodic(durationNext[T](d))
+}
+
+// PeriodicDurationSeq is a wrapper for the PeriodicSeq function.
+// The interval is specified by the time.Duration argument.
+func (p Period[T]) PeriodicDurationSeq(d time.Duration) iter.Seq[Time[T]] {
+	return p.PeriodicSeq(durationNext[T](d))
+}
+
+func durationNext[T TimeZone](d time.Duration) func(Time[T]) Time[T] {
+	return func(t Time[T]) Time[T] {
 		return t.Add(d)
-	})
+	}
 }
 
-// PeriodicDuration is a wrapper for the Periodic function.
+// PeriodicDate is a wrapper for the Periodic function.
 // The interval is specified by the given number of years, months, and days.
+//
+// Deprecated: Use PeriodicDateSeq instead.
 func (p Period[T]) PeriodicDate(years int, months int, days int) periodical[T] {
-	return p.Periodic(func(t Time[T]) Time[T] {
+	return p.Periodic(dateNext[T](years, months, days))
+}
+
+// PeriodicDateSeq is a wrapper for the PeriodicSeq function.
+// The interval is specified by the given number of years, months, and days.
+func (p Period[T]) PeriodicDateSeq(years int, months int, days int) iter.Seq[Time[T]] {
+	return p.PeriodicSeq(dateNext[T](years, months, days))
+}
+
+func dateNext[T TimeZone](years int, months int, days int) func(Time[T]) Time[T] {
+	return func(t Time[T]) Time[T] {
 		return t.AddDate(years, months, days)
-	})
+	}
 }
 
 // PeriodicAdvance is a wrapper for the Periodic function.
 // The interval is specified by the provided date and time unit arguments.
+//
+// Deprecated: Use PeriodicAdvanceSeq instead.
 func (p Period[T]) PeriodicAdvance(u1 Unit, u2 ...Unit) periodical[T] {
-	return p.Periodic(func(t Time[T]) Time[T] {
+	return p.Periodic(advanceNext[T](u1, u2...))
+}
+
+// PeriodicAdvanceSeq is a wrapper for the PeriodicSeq function.
+// The interval is specified by the provided date and time unit arguments.
+func (p Period[T]) PeriodicAdvanceSeq(u1 Unit, u2 ...Unit) iter.Seq[Time[T]] {
+	return p.PeriodicSeq(advanceNext[T](u1, u2...))
+}
+
+func advanceNext[T TimeZone](u1 Unit, u2 ...Unit) func(Time[T]) Time[T] {
+	return func(t Time[T]) Time[T] {
 		return t.Advance(u1, u2...)
-	})
+	}
 }
 
 // PeriodicISODuration is a wrapper for the Periodic function. It accepts a duration
@@ -143,7 +690,27 @@ func (p Period[T]) PeriodicAdvance(u1 Unit, u2 ...Unit) periodical[T] {
 //
 //	PnYnMnDTnHnMnS (e.g., P3Y6M4DT12H30M5S)
 //	PnW (e.g., P4W)
+//
+// Deprecated: Use PeriodicISODurationSeq instead.
 func (p Period[T]) PeriodicISODuration(duration string) (periodical[T], error) {
+	next, err := isoDurationNext[T](duration)
+	if err != nil {
+		return nil, err
+	}
+	return p.Periodic(next), nil
+}
+
+// PeriodicISODurationSeq is a wrapper for the PeriodicSeq function. It accepts
+// a duration in ISO 8601 format as a parameter like PeriodicISODuration.
+func (p Period[T]) PeriodicISODurationSeq(duration string) (iter.Seq[Time[T]], error) {
+	next, err := isoDurationNext[T](duration)
+	if err != nil {
+		return nil, err
+	}
+	return p.PeriodicSeq(next), nil
+}
+
+func isoDurationNext[T TimeZone](duration string) (func(Time[T]) Time[T], error) {
 	d, err := iso8601.ParseDuration(duration)
 	if err != nil {
 		return nil, err
@@ -151,34 +718,51 @@ func (p Period[T]) PeriodicISODuration(duration string) (periodical[T], error) {
 	if d.IsZero() {
 		return nil, fmt.Errorf("empty duration is not accepted: %q", duration)
 	}
-	sign := 1
-	if d.Negative {
-		sign = -1
-	}
-	return p.Periodic(func(t Time[T]) Time[T] {
-		var (
-			years  int
-			months int
-			days   int
-		)
+	return func(t Time[T]) Time[T] {
+		return t.AddISODuration(d)
+	}, nil
+}
 
-		if d.Year > 0 {
-			years = sign * d.Year
-		}
-		if d.Month > 0 {
-			months = sign * int(d.Month)
-		}
-		if d.Week > 0 {
-			days += sign * 7 * d.Week
-		}
-		if d.Day > 0 {
-			days += sign * d.Day
+// periodSide is the parsed from or to of a period.
+type periodSide[T TimeZone] struct {
+	t         Time[T]
+	unbounded bool
+	duration  *iso8601.Duration
+}
+
+func parsePeriodSide[T TimeZone, argType timeish[T]](argPtr unsafe.Pointer) (periodSide[T], error) {
+	if s, ok := stringArg[T, argType](argPtr); ok {
+		if s == ".." {
+			return periodSide[T]{unbounded: true}, nil
 		}
-		if years != 0 || months != 0 || days != 0 {
-			t = t.AddDate(years, months, days)
+		if strings.HasPrefix(strings.TrimLeft(s, "+-"), "P") {
+			d, err := iso8601.ParseDuration(s)
+			if err != nil {
+				return periodSide[T]{}, err
+			}
+			return periodSide[T]{duration: &d}, nil
 		}
-		return t.Add(d.StdClockDuration())
-	}), nil
+	}
+	t, err := convertTime[T, argType](argPtr)
+	if err != nil {
+		return periodSide[T]{}, err
+	}
+	return periodSide[T]{t: t}, nil
+}
+
+// stringArg returns the argument as string if it is a string or []byte.
+func stringArg[T TimeZone, argType timeish[T]](argPtr unsafe.Pointer) (string, bool) {
+	var dummy argType
+	switch any(dummy).(type) {
+	case Time[T], time.Time:
+		return "", false
+	case []byte:
+		bytes := *(*[]byte)(argPtr)
+		return unsafe.String(unsafe.SliceData(bytes), len(bytes)), true
+	default:
+		// argType is ~string, argPtr can be safely converted to *string
+		return *(*string)(argPtr), true
+	}
 }
 
 func convertTime[T TimeZone, argType timeish[T]](argPtr unsafe.Pointer) (Time[T], error) {
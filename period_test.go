@@ -1,7 +1,11 @@
 package synchro
 
 import (
+	"encoding/json"
 	"fmt"
+	"iter"
+	"math"
+	"slices"
 	"strings"
 	"testing"
 	"time"
@@ -28,6 +32,166 @@ func TestNewPeriod(t *testing.T) {
 			t.Fatal("want error")
 		}
 	})
+	t.Run("durations", func(t *testing.T) {
+		tests := []struct {
+			from, to string
+			want     Period[tz.UTC]
+		}{
+			{from: "2023-01-15", to: "P1M", want: mustPeriod(t, "2023-01-15", "2023-02-15")},
+			{from: "2023-01-31", to: "P1DT12H", want: mustPeriod(t, "2023-01-31", "2023-02-01T12:00:00Z")},
+			{from: "2023-01-31", to: "P1M", want: mustPeriod(t, "2023-01-31", "2023-02-28")},
+			{from: "P1M", to: "2023-03-31", want: mustPeriod(t, "2023-02-28", "2023-03-31")},
+			{from: "P1Y", to: "2024-02-29", want: mustPeriod(t, "2023-02-28", "2024-02-29")},
+			// "-P1D/end" starts one day after the end, which is a reversed period.
+			{from: "-P1D", to: "2023-01-01", want: mustPeriod(t, "2023-01-02", "2023-01-01")},
+		}
+		for _, tt := range tests {
+			got, err := NewPeriod[tz.UTC](tt.from, tt.to)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got.From() != tt.want.From() || got.To() != tt.want.To() {
+				t.Errorf("%s/%s: want %s but got %s", tt.from, tt.to, tt.want, got)
+			}
+		}
+		for _, args := range [][2]string{{"P1D", "P1D"}, {"P1D", ".."}, {"..", "P1D"}, {"2023-01-01", "P1X"}} {
+			if _, err := NewPeriod[tz.UTC](args[0], args[1]); err == nil {
+				t.Errorf("%s/%s: want error", args[0], args[1])
+			}
+		}
+	})
+}
+
+func TestPeriodFromInterval(t *testing.T) {
+	tests := []struct {
+		interval string
+		want     Period[tz.UTC]
+	}{
+		{interval: "2023-01-01T00:00:00Z/2023-02-01T00:00:00Z", want: mustPeriod(t, "2023-01-01", "2023-02-01")},
+		{interval: "2023-01-01T00:00:00Z/P1DT12H", want: mustPeriod(t, "2023-01-01", "2023-01-02T12:00:00Z")},
+		{interval: "PT12H/2023-01-02T00:00:00Z", want: mustPeriod(t, "2023-01-01T12:00:00Z", "2023-01-02")},
+		{interval: "2023-01-01T00:00:00+09:00/..", want: PeriodSince(New[tz.UTC](2022, 12, 31, 15, 0, 0, 0))},
+		{interval: "../2023-01-01T00:00:00Z", want: PeriodUntil(New[tz.UTC](2023, 1, 1, 0, 0, 0, 0))},
+		{interval: "R5/2023-01-01T00:00:00Z/P1D", want: mustPeriod(t, "2023-01-01", "2023-01-02")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.interval, func(t *testing.T) {
+			i, err := iso8601.ParseInterval(tt.interval)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, err := PeriodFromInterval[tz.UTC](i)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("(-want, +got)\n%s", diff)
+			}
+			back, err := PeriodFromInterval[tz.UTC](got.ISOInterval())
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(got, back); diff != "" {
+				t.Errorf("ISOInterval: (-want, +got)\n%s", diff)
+			}
+		})
+	}
+
+	i, err := iso8601.ParseInterval("P1D")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := PeriodFromInterval[tz.UTC](i); err == nil {
+		t.Error("want error for the duration only interval")
+	}
+}
+
+func TestPeriodsFromInterval(t *testing.T) {
+	tests := []struct {
+		interval string
+		limit    int
+		want     []Period[tz.UTC]
+	}{
+		{
+			interval: "R3/2023-01-01T00:00:00Z/P1D",
+			limit:    10,
+			want: []Period[tz.UTC]{
+				mustPeriod(t, "2023-01-01", "2023-01-02"),
+				mustPeriod(t, "2023-01-02", "2023-01-03"),
+				mustPeriod(t, "2023-01-03", "2023-01-04"),
+			},
+		},
+		{
+			interval: "2023-01-01T00:00:00Z/PT1H",
+			limit:    10,
+			want:     []Period[tz.UTC]{mustPeriod(t, "2023-01-01T00:00:00Z", "2023-01-01T01:00:00Z")},
+		},
+		{
+			interval: "R/PT1H/2023-01-01T00:00:00Z",
+			limit:    2,
+			want: []Period[tz.UTC]{
+				mustPeriod(t, "2022-12-31T23:00:00Z", "2023-01-01T00:00:00Z"),
+				mustPeriod(t, "2022-12-31T22:00:00Z", "2022-12-31T23:00:00Z"),
+			},
+		},
+		{
+			interval: "R2/2023-01-31T00:00:00Z/P1M",
+			limit:    10,
+			want: []Period[tz.UTC]{
+				mustPeriod(t, "2023-01-31", "2023-02-28"),
+				mustPeriod(t, "2023-02-28", "2023-03-31"),
+			},
+		},
+		{
+			interval: "2023-01-01T00:00:00Z/..",
+			limit:    10,
+			want:     []Period[tz.UTC]{PeriodSince(New[tz.UTC](2023, 1, 1, 0, 0, 0, 0))},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.interval, func(t *testing.T) {
+			i, err := iso8601.ParseInterval(tt.interval)
+			if err != nil {
+				t.Fatal(err)
+			}
+			seq, err := PeriodsFromInterval[tz.UTC](i)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var got []Period[tz.UTC]
+			for p := range seq {
+				if len(got) == tt.limit {
+					break
+				}
+				got = append(got, p)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("(-want, +got)\n%s", diff)
+			}
+		})
+	}
+
+	i, err := iso8601.ParseInterval("R2/2023-01-01T00:00:00Z/..")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := PeriodsFromInterval[tz.UTC](i); err == nil {
+		t.Error("want error for the repeating unbounded interval")
+	}
+}
+
+func TestPeriod_ISOInterval(t *testing.T) {
+	p := mustPeriod(t, "2023-02-01", "2023-01-01").WithBounds(BoundsOpen)
+	i := p.ISOInterval()
+	if want := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC); !i.Start().Equal(want) {
+		t.Errorf("want start %s but got %s", want, i.Start())
+	}
+	if want := time.Date(2023, 2, 1, 0, 0, 0, 0, time.UTC); !i.End().Equal(want) {
+		t.Errorf("want end %s but got %s", want, i.End())
+	}
+	if i := PeriodUntil(p.From()).ISOInterval(); !i.StartUnbounded() || i.EndUnbounded() {
+		t.Errorf("unexpected interval: %v", i)
+	}
 }
 
 func TestPeriod_Slice(t *testing.T) {
@@ -334,6 +498,20 @@ func TestPeriod_Contains(t *testing.T) {
 			to:   "2018-08-17",
 			want: -1,
 		},
+		{
+			name: "2018-08-16 is not included in the reversed period",
+			t:    New[tz.UTC](2018, 8, 16, 0, 0, 0, 0),
+			from: "2018-08-17",
+			to:   "2018-08-15",
+			want: -1,
+		},
+		{
+			name: "2018-08-17 is the same as from of the reversed period",
+			t:    New[tz.UTC](2018, 8, 17, 0, 0, 0, 0),
+			from: "2018-08-17",
+			to:   "2018-08-15",
+			want: 0,
+		},
 	}
 	for _, tc := range cases {
 		tc := tc
@@ -373,3 +551,424 @@ func TestPeriod_To(t *testing.T) {
 		t.Fatalf("(-want, +got)\n%s", diff)
 	}
 }
+
+func mustPeriod(t *testing.T, from, to string) Period[tz.UTC] {
+	t.Helper()
+	p, err := NewPeriod[tz.UTC](from, to)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return p
+}
+
+func TestPeriod_SetOperations(t *testing.T) {
+	allowPeriod := cmp.AllowUnexported(Period[tz.UTC]{})
+	p := mustPeriod(t, "2023-01-10", "2023-01-20")
+	cases := []struct {
+		name      string
+		q         Period[tz.UTC]
+		overlaps  bool
+		abuts     bool
+		intersect []Period[tz.UTC]
+		union     []Period[tz.UTC]
+		subtract  []Period[tz.UTC]
+		gap       []Period[tz.UTC]
+	}{
+		{
+			name:     "before",
+			q:        mustPeriod(t, "2023-01-01", "2023-01-05"),
+			union:    nil,
+			subtract: []Period[tz.UTC]{p},
+			gap:      []Period[tz.UTC]{mustPeriod(t, "2023-01-05", "2023-01-10").WithBounds(BoundsOpen)},
+		},
+		{
+			name:      "abuts before",
+			q:         mustPeriod(t, "2023-01-01", "2023-01-10"),
+			overlaps:  true,
+			abuts:     true,
+			intersect: []Period[tz.UTC]{mustPeriod(t, "2023-01-10", "2023-01-10")},
+			union:     []Period[tz.UTC]{mustPeriod(t, "2023-01-01", "2023-01-20")},
+			subtract:  []Period[tz.UTC]{p.WithBounds(BoundsOpenClosed)},
+		},
+		{
+			name:      "overlaps start",
+			q:         mustPeriod(t, "2023-01-05", "2023-01-15"),
+			overlaps:  true,
+			intersect: []Period[tz.UTC]{mustPeriod(t, "2023-01-10", "2023-01-15")},
+			union:     []Period[tz.UTC]{mustPeriod(t, "2023-01-05", "2023-01-20")},
+			subtract:  []Period[tz.UTC]{mustPeriod(t, "2023-01-15", "2023-01-20").WithBounds(BoundsOpenClosed)},
+		},
+		{
+			name:      "inside",
+			q:         mustPeriod(t, "2023-01-12", "2023-01-14"),
+			overlaps:  true,
+			intersect: []Period[tz.UTC]{mustPeriod(t, "2023-01-12", "2023-01-14")},
+			union:     []Period[tz.UTC]{p},
+			subtract: []Period[tz.UTC]{
+				mustPeriod(t, "2023-01-10", "2023-01-12").WithBounds(BoundsClosedOpen),
+				mustPeriod(t, "2023-01-14", "2023-01-20").WithBounds(BoundsOpenClosed),
+			},
+		},
+		{
+			name:      "covers",
+			q:         mustPeriod(t, "2023-01-01", "2023-01-31"),
+			overlaps:  true,
+			intersect: []Period[tz.UTC]{p},
+			union:     []Period[tz.UTC]{mustPeriod(t, "2023-01-01", "2023-01-31")},
+		},
+		{
+			name:      "reversed",
+			q:         mustPeriod(t, "2023-01-31", "2023-01-15"),
+			overlaps:  true,
+			intersect: []Period[tz.UTC]{mustPeriod(t, "2023-01-15", "2023-01-20")},
+			union:     []Period[tz.UTC]{mustPeriod(t, "2023-01-10", "2023-01-31")},
+			subtract:  []Period[tz.UTC]{mustPeriod(t, "2023-01-10", "2023-01-15").WithBounds(BoundsClosedOpen)},
+		},
+		{
+			name:     "after",
+			q:        mustPeriod(t, "2023-01-25", "2023-01-31"),
+			subtract: []Period[tz.UTC]{p},
+			gap:      []Period[tz.UTC]{mustPeriod(t, "2023-01-20", "2023-01-25").WithBounds(BoundsOpen)},
+		},
+	}
+	optional := func(p Period[tz.UTC], ok bool) []Period[tz.UTC] {
+		if !ok {
+			return nil
+		}
+		return []Period[tz.UTC]{p}
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := p.Overlaps(tc.q); got != tc.overlaps {
+				t.Errorf("Overlaps: want %v but got %v", tc.overlaps, got)
+			}
+			if got := tc.q.Overlaps(p); got != tc.overlaps {
+				t.Errorf("Overlaps (swapped): want %v but got %v", tc.overlaps, got)
+			}
+			if got := p.Abuts(tc.q); got != tc.abuts {
+				t.Errorf("Abuts: want %v but got %v", tc.abuts, got)
+			}
+			if diff := cmp.Diff(tc.intersect, optional(p.Intersect(tc.q)), allowPeriod); diff != "" {
+				t.Errorf("Intersect: (-want, +got)\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.union, optional(p.Union(tc.q)), allowPeriod); diff != "" {
+				t.Errorf("Union: (-want, +got)\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.subtract, p.Subtract(tc.q), allowPeriod); diff != "" {
+				t.Errorf("Subtract: (-want, +got)\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.gap, optional(p.Gap(tc.q)), allowPeriod); diff != "" {
+				t.Errorf("Gap: (-want, +got)\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestPeriod_Duration(t *testing.T) {
+	want := 48 * time.Hour
+	if got := mustPeriod(t, "2023-01-10", "2023-01-12").Duration(); got != want {
+		t.Errorf("want %v but got %v", want, got)
+	}
+	if got := mustPeriod(t, "2023-01-12", "2023-01-10").Duration(); got != want {
+		t.Errorf("want %v for reversed period but got %v", want, got)
+	}
+}
+
+func TestPeriod_PeriodicSeq(t *testing.T) {
+	p := mustPeriod(t, "2014-02-05", "2014-02-08")
+	want := p.PeriodicDuration(24 * time.Hour).Slice()
+	seqs := map[string]func(p Period[tz.UTC]) iter.Seq[Time[tz.UTC]]{
+		"PeriodicDurationSeq": func(p Period[tz.UTC]) iter.Seq[Time[tz.UTC]] {
+			return p.PeriodicDurationSeq(24 * time.Hour)
+		},
+		"PeriodicDateSeq": func(p Period[tz.UTC]) iter.Seq[Time[tz.UTC]] {
+			return p.PeriodicDateSeq(0, 0, 1)
+		},
+		"PeriodicAdvanceSeq": func(p Period[tz.UTC]) iter.Seq[Time[tz.UTC]] {
+			return p.PeriodicAdvanceSeq(Day(1))
+		},
+		"PeriodicISODurationSeq": func(p Period[tz.UTC]) iter.Seq[Time[tz.UTC]] {
+			seq, err := p.PeriodicISODurationSeq("P1D")
+			if err != nil {
+				t.Fatal(err)
+			}
+			return seq
+		},
+	}
+	for name, seq := range seqs {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(want, slices.Collect(seq(p))); diff != "" {
+				t.Fatalf("(-want, +got)\n%s", diff)
+			}
+
+			var got []Time[tz.UTC]
+			for current := range seq(p) {
+				if len(got) == 2 {
+					break
+				}
+				got = append(got, current)
+			}
+			if diff := cmp.Diff(want[:2], got); diff != "" {
+				t.Fatalf("early termination: (-want, +got)\n%s", diff)
+			}
+
+			short, long := mustPeriod(t, "2014-01-01", "2014-01-10"), mustPeriod(t, "2014-01-01", "2014-12-31")
+			count := func(p Period[tz.UTC]) float64 {
+				s := seq(p)
+				return testing.AllocsPerRun(10, func() {
+					for range s {
+					}
+				})
+			}
+			if a, b := count(short), count(long); a != b {
+				t.Errorf("want no allocation for each step but got %v for 9 steps and %v for 364 steps", a, b)
+			}
+		})
+	}
+
+	t.Run("invalid duration", func(t *testing.T) {
+		if _, err := p.PeriodicISODurationSeq("P0D"); err == nil {
+			t.Fatal("want error")
+		}
+	})
+}
+
+func TestPeriod_Unbounded(t *testing.T) {
+	start := New[tz.UTC](2023, 1, 1, 0, 0, 0, 0)
+	end := New[tz.UTC](2023, 1, 10, 0, 0, 0, 0)
+	since := PeriodSince(start)
+	until := PeriodUntil(end)
+	all, err := NewPeriod[tz.UTC]("..", "..")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("NewPeriod", func(t *testing.T) {
+		p, err := NewPeriod[tz.UTC]("2023-01-01", []byte(".."))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(since, p); diff != "" {
+			t.Errorf("(-want, +got)\n%s", diff)
+		}
+		if !p.IsToUnbounded() || p.IsFromUnbounded() || p.Bounds() != BoundsClosedOpen {
+			t.Errorf("unexpected period: %s", p)
+		}
+		p, err = NewPeriod[tz.UTC]("..", end)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(until, p); diff != "" {
+			t.Errorf("(-want, +got)\n%s", diff)
+		}
+		if got := since.WithBounds(BoundsOpen).WithBounds(BoundsClosed).Bounds(); got != BoundsClosedOpen {
+			t.Errorf("want the unbounded side to be excluded but got %s", got)
+		}
+	})
+
+	t.Run("String", func(t *testing.T) {
+		if got, want := since.String(), "from 2023-01-01 00:00:00 +0000 UTC to infinity [)"; got != want {
+			t.Errorf("want %q but got %q", want, got)
+		}
+		if got, want := all.String(), "from -infinity to infinity ()"; got != want {
+			t.Errorf("want %q but got %q", want, got)
+		}
+	})
+
+	t.Run("Contains", func(t *testing.T) {
+		far := New[tz.UTC](9999, 12, 31, 0, 0, 0, 0)
+		past := New[tz.UTC](1, 1, 1, 0, 0, 0, 0)
+		tests := []struct {
+			p    Period[tz.UTC]
+			t    Time[tz.UTC]
+			want int
+		}{
+			{since, far, 1},
+			{since, start, 0},
+			{since, past, -1},
+			{until, past, 1},
+			{until, end, 0},
+			{until, far, -1},
+			{all, far, 1},
+			{all, past, 1},
+		}
+		for _, tt := range tests {
+			if got := tt.p.Contains(tt.t); got != tt.want {
+				t.Errorf("%s contains %s: want %d but got %d", tt.p, tt.t, tt.want, got)
+			}
+		}
+	})
+
+	t.Run("Duration", func(t *testing.T) {
+		if got := since.Duration(); got != math.MaxInt64 {
+			t.Errorf("want max duration but got %s", got)
+		}
+		if got := NewPeriodSet(until).Duration(); got != math.MaxInt64 {
+			t.Errorf("want max duration but got %s", got)
+		}
+	})
+
+	t.Run("PeriodicSeq", func(t *testing.T) {
+		var got []Time[tz.UTC]
+		for tm := range since.PeriodicDateSeq(0, 0, 1) {
+			if len(got) == 3 {
+				break
+			}
+			got = append(got, tm)
+		}
+		want := []Time[tz.UTC]{start, start.AddDate(0, 0, 1), start.AddDate(0, 0, 2)}
+		if diff := cmp.Diff(want, got); diff != "" {
+			t.Errorf("(-want, +got)\n%s", diff)
+		}
+		if got := slices.Collect(since.PeriodicDurationSeq(0)); len(got) != 1 {
+			t.Errorf("want the iteration to stop if next does not advance, but got %v", got)
+		}
+		if got := slices.Collect(until.PeriodicDateSeq(0, 0, 1)); len(got) != 0 {
+			t.Errorf("want no times without from but got %v", got)
+		}
+	})
+
+	t.Run("SetOperations", func(t *testing.T) {
+		p := mustPeriod(t, "2023-01-05", "2023-01-20")
+		got, ok := since.Intersect(until)
+		if want := mustPeriod(t, "2023-01-01", "2023-01-10"); !ok || !got.Equal(want) {
+			t.Errorf("want %s but got %s", want, got)
+		}
+		if u, ok := since.Union(until); !ok || !u.Equal(all) {
+			t.Errorf("want %s but got %s", all, u)
+		}
+		if got, want := p.Relation(since), RelationDuring; got != want {
+			t.Errorf("want %s but got %s", want, got)
+		}
+		if got, want := until.Relation(all), RelationStarts; got != want {
+			t.Errorf("want %s but got %s", want, got)
+		}
+		if got, want := since.Relation(all), RelationFinishes; got != want {
+			t.Errorf("want %s but got %s", want, got)
+		}
+		wantParts := []Period[tz.UTC]{
+			PeriodUntil(start).WithBounds(BoundsOpen),
+			PeriodSince(end).WithBounds(BoundsOpen),
+		}
+		if diff := cmp.Diff(wantParts, all.Subtract(mustPeriod(t, "2023-01-01", "2023-01-10"))); diff != "" {
+			t.Errorf("Subtract: (-want, +got)\n%s", diff)
+		}
+		s := NewPeriodSet(since, p)
+		if diff := cmp.Diff([]Period[tz.UTC]{since}, s.Periods()); diff != "" {
+			t.Errorf("NewPeriodSet: (-want, +got)\n%s", diff)
+		}
+		if !s.Contains(New[tz.UTC](9999, 1, 1, 0, 0, 0, 0)) {
+			t.Error("want the far future to be contained")
+		}
+		rest := NewPeriodSet(all).Subtract(NewPeriodSet(p))
+		wantRest := []Period[tz.UTC]{
+			PeriodUntil(p.From()).WithBounds(BoundsOpen),
+			PeriodSince(p.To()).WithBounds(BoundsOpen),
+		}
+		if diff := cmp.Diff(wantRest, rest.Periods()); diff != "" {
+			t.Errorf("PeriodSet.Subtract: (-want, +got)\n%s", diff)
+		}
+	})
+
+	t.Run("MarshalText", func(t *testing.T) {
+		for _, p := range []Period[tz.UTC]{since, until, all} {
+			b, err := p.MarshalText()
+			if err != nil {
+				t.Fatal(err)
+			}
+			interval, err := iso8601.ParseInterval(string(b))
+			if err != nil {
+				t.Fatalf("%s: %v", b, err)
+			}
+			if interval.StartUnbounded() != p.IsFromUnbounded() || interval.EndUnbounded() != p.IsToUnbounded() {
+				t.Errorf("%s: unexpected interval %v", b, interval)
+			}
+		}
+		b, _ := since.MarshalText()
+		if got, want := string(b), "2023-01-01T00:00:00Z/.."; got != want {
+			t.Errorf("want %q but got %q", want, got)
+		}
+	})
+}
+
+func TestPeriod_MarshalText(t *testing.T) {
+	p := mustPeriod(t, "2023-01-01", "2023-02-01")
+	reversed := mustPeriod(t, "2023-02-01", "2023-01-01")
+	tests := []struct {
+		name   string
+		period Period[tz.UTC]
+		want   string
+	}{
+		{name: "closed", period: p, want: "2023-01-01T00:00:00Z/2023-02-01T00:00:00Z"},
+		{name: "closed-open", period: p.WithBounds(BoundsClosedOpen), want: "[2023-01-01T00:00:00Z/2023-02-01T00:00:00Z)"},
+		{name: "open", period: p.WithBounds(BoundsOpen), want: "(2023-01-01T00:00:00Z/2023-02-01T00:00:00Z)"},
+		{name: "reversed", period: reversed.WithBounds(BoundsOpenClosed), want: "(2023-02-01T00:00:00Z/2023-01-01T00:00:00Z]"},
+		{name: "since", period: PeriodSince(p.From()), want: "2023-01-01T00:00:00Z/.."},
+		{name: "since excluded", period: PeriodSince(p.From()).WithBounds(BoundsOpen), want: "(2023-01-01T00:00:00Z/..)"},
+		{name: "until", period: PeriodUntil(p.To()), want: "../2023-02-01T00:00:00Z"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b, err := tt.period.MarshalText()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := string(b); got != tt.want {
+				t.Errorf("want %q but got %q", tt.want, got)
+			}
+			var got Period[tz.UTC]
+			if err := got.UnmarshalText(b); err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tt.period, got); diff != "" {
+				t.Errorf("(-want, +got)\n%s", diff)
+			}
+		})
+	}
+
+	t.Run("UnmarshalText", func(t *testing.T) {
+		var got Period[tz.AsiaTokyo]
+		if err := got.UnmarshalText([]byte("[2023-01-01T00:00:00+09:00/2023-01-02T00:00:00Z)")); err != nil {
+			t.Fatal(err)
+		}
+		want, err := NewPeriod[tz.AsiaTokyo]("2023-01-01T00:00:00+09:00", "2023-01-02T09:00:00+09:00")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want = want.WithBounds(BoundsClosedOpen); !got.Equal(want) {
+			t.Errorf("want %s but got %s", want, got)
+		}
+		for _, s := range []string{"", "2023-01-01", "[2023-01-01/2023-01-02", "{2023-01-01/2023-01-02}", "2023-01-01/unknown"} {
+			if err := got.UnmarshalText([]byte(s)); err == nil {
+				t.Errorf("%q: want error", s)
+			}
+		}
+	})
+
+	t.Run("JSON", func(t *testing.T) {
+		type object struct {
+			Period Period[tz.UTC] `json:"period"`
+		}
+		want := object{Period: p.WithBounds(BoundsClosedOpen)}
+		b, err := json.Marshal(want)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got, want := string(b), `{"period":"[2023-01-01T00:00:00Z/2023-02-01T00:00:00Z)"}`; got != want {
+			t.Errorf("want %s but got %s", want, got)
+		}
+		var got object
+		if err := json.Unmarshal(b, &got); err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(want, got); diff != "" {
+			t.Errorf("(-want, +got)\n%s", diff)
+		}
+		if err := json.Unmarshal([]byte(`{"period":null}`), &got); err != nil {
+			t.Fatal(err)
+		}
+		if err := json.Unmarshal([]byte(`{"period":1}`), &got); err == nil {
+			t.Error("want error for non-string")
+		}
+	})
+}
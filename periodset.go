@@ -0,0 +1,159 @@
+package synchro
+
+import (
+	"math"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+)
+
+// PeriodSet is a set of times represented by periods. The periods are kept
+// normalized: sorted in chronological order, overlapping or abutting periods
+// are merged into one, and empty periods are removed. The bounds of
+// the periods are honored.
+//
+// The zero value is an empty set.
+type PeriodSet[T TimeZone] struct {
+	periods []Period[T]
+}
+
+// NewPeriodSet creates a new PeriodSet which covers the specified periods.
+func NewPeriodSet[T TimeZone](periods ...Period[T]) PeriodSet[T] {
+	if len(periods) == 0 {
+		return PeriodSet[T]{}
+	}
+	ps := make([]Period[T], 0, len(periods))
+	for _, p := range periods {
+		if !p.IsEmpty() {
+			ps = append(ps, periodOf(p.lower(), p.upper()))
+		}
+	}
+	slices.SortFunc(ps, func(a, b Period[T]) int {
+		return compareLower(a.lower(), b.lower())
+	})
+	var merged []Period[T]
+	for _, p := range ps {
+		if n := len(merged); n > 0 {
+			if u, ok := merged[n-1].Union(p); ok {
+				merged[n-1] = u
+				continue
+			}
+		}
+		merged = append(merged, p)
+	}
+	return PeriodSet[T]{periods: merged}
+}
+
+// String implements the fmt.Stringer interface.
+func (s PeriodSet[T]) String() string {
+	strs := make([]string, len(s.periods))
+	for i, p := range s.periods {
+		strs[i] = p.String()
+	}
+	return "{" + strings.Join(strs, ", ") + "}"
+}
+
+// Periods returns the normalized periods in chronological order.
+func (s PeriodSet[T]) Periods() []Period[T] {
+	return slices.Clone(s.periods)
+}
+
+// IsEmpty reports whether s has no periods.
+func (s PeriodSet[T]) IsEmpty() bool {
+	return len(s.periods) == 0
+}
+
+// Duration returns the total length of the periods. If s has an unbounded
+// period, it returns the maximum time.Duration.
+func (s PeriodSet[T]) Duration() time.Duration {
+	var d time.Duration
+	for _, p := range s.periods {
+		if p.fromUnbounded || p.toUnbounded {
+			return math.MaxInt64
+		}
+		d += p.Duration()
+	}
+	return d
+}
+
+// Contains reports whether t is covered by any period of s.
+func (s PeriodSet[T]) Contains(t Time[T]) bool {
+	// The first period which ends at or after t. The next period does not
+	// contain t even if this period excludes t, as they are not abutting.
+	i := sort.Search(len(s.periods), func(i int) bool {
+		return s.periods[i].upper().compareTime(t) >= 0
+	})
+	return i < len(s.periods) && s.periods[i].Contains(t) >= 0
+}
+
+// Add returns the set which covers s and the specified periods.
+func (s PeriodSet[T]) Add(periods ...Period[T]) PeriodSet[T] {
+	return NewPeriodSet(append(slices.Clone(s.periods), periods...)...)
+}
+
+// Union returns the set which covers both s and u.
+func (s PeriodSet[T]) Union(u PeriodSet[T]) PeriodSet[T] {
+	return s.Add(u.periods...)
+}
+
+// Intersect returns the set which is covered by both s and u.
+func (s PeriodSet[T]) Intersect(u PeriodSet[T]) PeriodSet[T] {
+	var ps []Period[T]
+	for i, j := 0, 0; i < len(s.periods) && j < len(u.periods); {
+		p, q := s.periods[i], u.periods[j]
+		if r, ok := p.Intersect(q); ok {
+			ps = append(ps, r)
+		}
+		if compareUpper(p.upper(), q.upper()) < 0 {
+			i++
+		} else {
+			j++
+		}
+	}
+	return PeriodSet[T]{periods: ps}
+}
+
+// Subtract returns the set which is covered by s but not by u.
+func (s PeriodSet[T]) Subtract(u PeriodSet[T]) PeriodSet[T] {
+	var ps []Period[T]
+	j := 0
+	for _, p := range s.periods {
+		// Skip the periods of u which end before p.
+		for j < len(u.periods) && compareBound(u.periods[j].upper(), p.lower()) < 0 {
+			j++
+		}
+		rest, covered := p, false
+		for k := j; k < len(u.periods) && compareBound(u.periods[k].lower(), rest.upper()) <= 0; k++ {
+			parts := rest.Subtract(u.periods[k])
+			// The part after the period of u is subtracted by the next one.
+			if n := len(parts); n > 0 && compareUpper(parts[n-1].upper(), u.periods[k].upper()) > 0 {
+				rest, parts = parts[n-1], parts[:n-1]
+			} else {
+				covered = true
+			}
+			ps = append(ps, parts...)
+			if covered {
+				break
+			}
+		}
+		if !covered {
+			ps = append(ps, rest)
+		}
+	}
+	return PeriodSet[T]{periods: ps}
+}
+
+// Gaps returns the set of periods between the periods of s.
+func (s PeriodSet[T]) Gaps() PeriodSet[T] {
+	if len(s.periods) < 2 {
+		return PeriodSet[T]{}
+	}
+	ps := make([]Period[T], 0, len(s.periods)-1)
+	for i := 1; i < len(s.periods); i++ {
+		if gap, ok := s.periods[i-1].Gap(s.periods[i]); ok {
+			ps = append(ps, gap)
+		}
+	}
+	return PeriodSet[T]{periods: ps}
+}
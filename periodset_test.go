@@ -0,0 +1,160 @@
+package synchro
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/Code-Hex/synchro/tz"
+	"github.com/google/go-cmp/cmp"
+)
+
+var _ interface {
+	fmt.Stringer
+} = (*PeriodSet[tz.UTC])(nil)
+
+func TestNewPeriodSet(t *testing.T) {
+	allowPeriod := cmp.AllowUnexported(Period[tz.UTC]{})
+	cases := []struct {
+		name    string
+		periods []Period[tz.UTC]
+		want    []Period[tz.UTC]
+	}{
+		{name: "empty"},
+		{
+			name: "sorted and merged",
+			periods: []Period[tz.UTC]{
+				mustPeriod(t, "2023-01-20", "2023-01-25"),
+				mustPeriod(t, "2023-01-01", "2023-01-05"),
+				mustPeriod(t, "2023-01-04", "2023-01-10"),
+				mustPeriod(t, "2023-01-25", "2023-01-22"),
+				mustPeriod(t, "2023-01-25", "2023-01-28"),
+			},
+			want: []Period[tz.UTC]{
+				mustPeriod(t, "2023-01-01", "2023-01-10"),
+				mustPeriod(t, "2023-01-20", "2023-01-28"),
+			},
+		},
+		{
+			name: "contained",
+			periods: []Period[tz.UTC]{
+				mustPeriod(t, "2023-01-01", "2023-01-31"),
+				mustPeriod(t, "2023-01-10", "2023-01-12"),
+			},
+			want: []Period[tz.UTC]{
+				mustPeriod(t, "2023-01-01", "2023-01-31"),
+			},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			s := NewPeriodSet(tc.periods...)
+			if diff := cmp.Diff(tc.want, s.Periods(), allowPeriod); diff != "" {
+				t.Errorf("(-want, +got)\n%s", diff)
+			}
+			if got := s.IsEmpty(); got != (len(tc.want) == 0) {
+				t.Errorf("IsEmpty: got %v", got)
+			}
+		})
+	}
+}
+
+func TestPeriodSet_Operations(t *testing.T) {
+	allowPeriod := cmp.AllowUnexported(Period[tz.UTC]{})
+	// Shifts and downtime windows.
+	s := NewPeriodSet(
+		mustPeriod(t, "2023-01-01T09:00:00", "2023-01-01T17:00:00"),
+		mustPeriod(t, "2023-01-02T09:00:00", "2023-01-02T17:00:00"),
+	)
+	u := NewPeriodSet(
+		mustPeriod(t, "2023-01-01T08:00:00", "2023-01-01T10:00:00"),
+		mustPeriod(t, "2023-01-01T12:00:00", "2023-01-01T13:00:00"),
+		mustPeriod(t, "2023-01-02T16:00:00", "2023-01-03T00:00:00"),
+	)
+	cases := []struct {
+		name string
+		got  PeriodSet[tz.UTC]
+		want []Period[tz.UTC]
+	}{
+		{
+			name: "union",
+			got:  s.Union(u),
+			want: []Period[tz.UTC]{
+				mustPeriod(t, "2023-01-01T08:00:00", "2023-01-01T17:00:00"),
+				mustPeriod(t, "2023-01-02T09:00:00", "2023-01-03T00:00:00"),
+			},
+		},
+		{
+			name: "intersect",
+			got:  s.Intersect(u),
+			want: []Period[tz.UTC]{
+				mustPeriod(t, "2023-01-01T09:00:00", "2023-01-01T10:00:00"),
+				mustPeriod(t, "2023-01-01T12:00:00", "2023-01-01T13:00:00"),
+				mustPeriod(t, "2023-01-02T16:00:00", "2023-01-02T17:00:00"),
+			},
+		},
+		{
+			name: "subtract",
+			got:  s.Subtract(u),
+			want: []Period[tz.UTC]{
+				mustPeriod(t, "2023-01-01T10:00:00", "2023-01-01T12:00:00").WithBounds(BoundsOpen),
+				mustPeriod(t, "2023-01-01T13:00:00", "2023-01-01T17:00:00").WithBounds(BoundsOpenClosed),
+				mustPeriod(t, "2023-01-02T09:00:00", "2023-01-02T16:00:00").WithBounds(BoundsClosedOpen),
+			},
+		},
+		{
+			name: "subtract reversed",
+			got:  u.Subtract(s),
+			want: []Period[tz.UTC]{
+				mustPeriod(t, "2023-01-01T08:00:00", "2023-01-01T09:00:00").WithBounds(BoundsClosedOpen),
+				mustPeriod(t, "2023-01-02T17:00:00", "2023-01-03T00:00:00").WithBounds(BoundsOpenClosed),
+			},
+		},
+		{
+			name: "gaps",
+			got:  s.Gaps(),
+			want: []Period[tz.UTC]{
+				mustPeriod(t, "2023-01-01T17:00:00", "2023-01-02T09:00:00").WithBounds(BoundsOpen),
+			},
+		},
+		{
+			name: "add",
+			got:  s.Add(mustPeriod(t, "2023-01-01T17:00:00", "2023-01-02T09:00:00")),
+			want: []Period[tz.UTC]{
+				mustPeriod(t, "2023-01-01T09:00:00", "2023-01-02T17:00:00"),
+			},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, tc.got.Periods(), allowPeriod); diff != "" {
+				t.Errorf("(-want, +got)\n%s", diff)
+			}
+		})
+	}
+
+	if got, want := s.Duration(), 16*time.Hour; got != want {
+		t.Errorf("Duration: want %v but got %v", want, got)
+	}
+	if got, want := s.Subtract(u).Duration(), 13*time.Hour; got != want {
+		t.Errorf("Duration of subtract: want %v but got %v", want, got)
+	}
+	for _, tc := range []struct {
+		t    Time[tz.UTC]
+		want bool
+	}{
+		{t: New[tz.UTC](2023, 1, 1, 8, 0, 0, 0), want: false},
+		{t: New[tz.UTC](2023, 1, 1, 9, 0, 0, 0), want: true},
+		{t: New[tz.UTC](2023, 1, 1, 17, 0, 0, 0), want: true},
+		{t: New[tz.UTC](2023, 1, 2, 0, 0, 0, 0), want: false},
+		{t: New[tz.UTC](2023, 1, 2, 12, 0, 0, 0), want: true},
+		{t: New[tz.UTC](2023, 1, 3, 0, 0, 0, 0), want: false},
+	} {
+		if got := s.Contains(tc.t); got != tc.want {
+			t.Errorf("Contains(%s): want %v but got %v", tc.t, tc.want, got)
+		}
+	}
+	if got := (PeriodSet[tz.UTC]{}).Contains(New[tz.UTC](2023, 1, 1, 0, 0, 0, 0)); got {
+		t.Error("want false for empty set")
+	}
+}
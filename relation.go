@@ -0,0 +1,162 @@
+package synchro
+
+// Relation is one of the 13 relations of Allen's interval algebra, which
+// classifies how two periods relate to each other.
+type Relation int
+
+const (
+	// RelationBefore means p ends before q starts.
+	RelationBefore Relation = iota + 1
+	// RelationMeets means p ends when q starts.
+	RelationMeets
+	// RelationOverlaps means p starts before q starts and ends during q.
+	RelationOverlaps
+	// RelationStarts means p starts when q starts and ends before q ends.
+	RelationStarts
+	// RelationDuring means p starts after q starts and ends before q ends.
+	RelationDuring
+	// RelationFinishes means p starts after q starts and ends when q ends.
+	RelationFinishes
+	// RelationEquals means p starts and ends when q does.
+	RelationEquals
+	// RelationFinishedBy is the inverse of RelationFinishes.
+	RelationFinishedBy
+	// RelationContains is the inverse of RelationDuring.
+	RelationContains
+	// RelationStartedBy is the inverse of RelationStarts.
+	RelationStartedBy
+	// RelationOverlappedBy is the inverse of RelationOverlaps.
+	RelationOverlappedBy
+	// RelationMetBy is the inverse of RelationMeets.
+	RelationMetBy
+	// RelationAfter is the inverse of RelationBefore.
+	RelationAfter
+)
+
+var relationNames = [...]string{
+	RelationBefore:       "before",
+	RelationMeets:        "meets",
+	RelationOverlaps:     "overlaps",
+	RelationStarts:       "starts",
+	RelationDuring:       "during",
+	RelationFinishes:     "finishes",
+	RelationEquals:       "equals",
+	RelationFinishedBy:   "finished by",
+	RelationContains:     "contains",
+	RelationStartedBy:    "started by",
+	RelationOverlappedBy: "overlapped by",
+	RelationMetBy:        "met by",
+	RelationAfter:        "after",
+}
+
+// String implements the fmt.Stringer interface.
+func (r Relation) String() string {
+	if r < RelationBefore || r > RelationAfter {
+		return "unknown"
+	}
+	return relationNames[r]
+}
+
+// Inverse returns the relation of q to p when r is the relation of p to q.
+func (r Relation) Inverse() Relation {
+	if r < RelationBefore || r > RelationAfter {
+		return r
+	}
+	return RelationAfter + RelationBefore - r
+}
+
+// Relation returns the relation of p to q.
+//
+// As Contains, the bounds of the periods are honored. The periods start or end
+// at the same time only if both endpoints are included or excluded, and p meets
+// q only if the end of p or the start of q is included. For example, [a, b)
+// meets [b, c), but (a, b) is before (b, c). A period whose from and to are the
+// same is a single instant; it starts or finishes q if it is the same as the
+// start or the end of q. The endpoints are compared in chronological order,
+// even if from is after to. Unbounded periods start or end at the same
+// infinity.
+func (p Period[T]) Relation(q Period[T]) Relation {
+	pl, pu, ql, qu := p.lower(), p.upper(), q.lower(), q.upper()
+	cs, ce := compareLower(pl, ql), compareUpper(pu, qu)
+	switch {
+	case cs == 0 && ce == 0:
+		return RelationEquals
+	case cs == 0 && ce < 0:
+		return RelationStarts
+	case cs == 0:
+		return RelationStartedBy
+	case ce == 0 && cs > 0:
+		return RelationFinishes
+	case ce == 0:
+		return RelationFinishedBy
+	}
+	switch compareBound(pu, ql) {
+	case -1:
+		return RelationBefore
+	case 0:
+		if !adjoins(pu, ql) {
+			return RelationBefore
+		}
+		return RelationMeets
+	}
+	switch compareBound(pl, qu) {
+	case 1:
+		return RelationAfter
+	case 0:
+		if !adjoins(qu, pl) {
+			return RelationAfter
+		}
+		return RelationMetBy
+	}
+	switch {
+	case cs < 0 && ce > 0:
+		return RelationContains
+	case cs > 0 && ce < 0:
+		return RelationDuring
+	case cs < 0:
+		return RelationOverlaps
+	}
+	return RelationOverlappedBy
+}
+
+// Before reports whether p ends before q starts.
+func (p Period[T]) Before(q Period[T]) bool { return p.Relation(q) == RelationBefore }
+
+// Meets reports whether p ends when q starts.
+func (p Period[T]) Meets(q Period[T]) bool { return p.Relation(q) == RelationMeets }
+
+// OverlapsStart reports whether p starts before q starts and ends during q.
+// Unlike Overlaps, it is only the case of RelationOverlaps.
+func (p Period[T]) OverlapsStart(q Period[T]) bool { return p.Relation(q) == RelationOverlaps }
+
+// Starts reports whether p starts when q starts and ends before q ends.
+func (p Period[T]) Starts(q Period[T]) bool { return p.Relation(q) == RelationStarts }
+
+// During reports whether p starts after q starts and ends before q ends.
+func (p Period[T]) During(q Period[T]) bool { return p.Relation(q) == RelationDuring }
+
+// Finishes reports whether p starts after q starts and ends when q ends.
+func (p Period[T]) Finishes(q Period[T]) bool { return p.Relation(q) == RelationFinishes }
+
+// Equal reports whether p starts and ends when q does, including the bounds.
+func (p Period[T]) Equal(q Period[T]) bool { return p.Relation(q) == RelationEquals }
+
+// FinishedBy reports whether q finishes p.
+func (p Period[T]) FinishedBy(q Period[T]) bool { return p.Relation(q) == RelationFinishedBy }
+
+// Encloses reports whether q is during p. It is named so as not to conflict
+// with Contains.
+func (p Period[T]) Encloses(q Period[T]) bool { return p.Relation(q) == RelationContains }
+
+// StartedBy reports whether q starts p.
+func (p Period[T]) StartedBy(q Period[T]) bool { return p.Relation(q) == RelationStartedBy }
+
+// OverlapsEnd reports whether p starts during q and ends after q ends.
+// Unlike Overlaps, it is only the case of RelationOverlappedBy.
+func (p Period[T]) OverlapsEnd(q Period[T]) bool { return p.Relation(q) == RelationOverlappedBy }
+
+// MetBy reports whether p starts when q ends.
+func (p Period[T]) MetBy(q Period[T]) bool { return p.Relation(q) == RelationMetBy }
+
+// After reports whether p starts after q ends.
+func (p Period[T]) After(q Period[T]) bool { return p.Relation(q) == RelationAfter }
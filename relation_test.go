@@ -0,0 +1,78 @@
+package synchro
+
+import (
+	"testing"
+
+	"github.com/Code-Hex/synchro/tz"
+)
+
+func TestPeriod_Relation(t *testing.T) {
+	q := mustPeriod(t, "2023-01-10", "2023-01-20")
+	cases := []struct {
+		p    Period[tz.UTC]
+		want Relation
+		pred func(p, q Period[tz.UTC]) bool
+	}{
+		{p: mustPeriod(t, "2023-01-01", "2023-01-05"), want: RelationBefore, pred: Period[tz.UTC].Before},
+		{p: mustPeriod(t, "2023-01-01", "2023-01-10"), want: RelationMeets, pred: Period[tz.UTC].Meets},
+		{p: mustPeriod(t, "2023-01-01", "2023-01-15"), want: RelationOverlaps, pred: Period[tz.UTC].OverlapsStart},
+		{p: mustPeriod(t, "2023-01-10", "2023-01-15"), want: RelationStarts, pred: Period[tz.UTC].Starts},
+		{p: mustPeriod(t, "2023-01-12", "2023-01-15"), want: RelationDuring, pred: Period[tz.UTC].During},
+		{p: mustPeriod(t, "2023-01-15", "2023-01-20"), want: RelationFinishes, pred: Period[tz.UTC].Finishes},
+		{p: mustPeriod(t, "2023-01-10", "2023-01-20"), want: RelationEquals, pred: Period[tz.UTC].Equal},
+		{p: mustPeriod(t, "2023-01-01", "2023-01-20"), want: RelationFinishedBy, pred: Period[tz.UTC].FinishedBy},
+		{p: mustPeriod(t, "2023-01-01", "2023-01-31"), want: RelationContains, pred: Period[tz.UTC].Encloses},
+		{p: mustPeriod(t, "2023-01-10", "2023-01-31"), want: RelationStartedBy, pred: Period[tz.UTC].StartedBy},
+		{p: mustPeriod(t, "2023-01-15", "2023-01-31"), want: RelationOverlappedBy, pred: Period[tz.UTC].OverlapsEnd},
+		{p: mustPeriod(t, "2023-01-20", "2023-01-31"), want: RelationMetBy, pred: Period[tz.UTC].MetBy},
+		{p: mustPeriod(t, "2023-01-25", "2023-01-31"), want: RelationAfter, pred: Period[tz.UTC].After},
+		// Reversed periods are compared in chronological order.
+		{p: mustPeriod(t, "2023-01-15", "2023-01-01"), want: RelationOverlaps, pred: Period[tz.UTC].OverlapsStart},
+		// Single instants are included in q at the endpoints.
+		{p: mustPeriod(t, "2023-01-10", "2023-01-10"), want: RelationStarts, pred: Period[tz.UTC].Starts},
+		{p: mustPeriod(t, "2023-01-20", "2023-01-20"), want: RelationFinishes, pred: Period[tz.UTC].Finishes},
+		{p: mustPeriod(t, "2023-01-15", "2023-01-15"), want: RelationDuring, pred: Period[tz.UTC].During},
+	}
+	for _, tc := range cases {
+		t.Run(tc.want.String()+" "+tc.p.String(), func(t *testing.T) {
+			if got := tc.p.Relation(q); got != tc.want {
+				t.Fatalf("want %s but got %s", tc.want, got)
+			}
+			if got, want := q.Relation(tc.p), tc.want.Inverse(); got != want {
+				t.Errorf("inverse: want %s but got %s", want, got)
+			}
+			if !tc.pred(tc.p, q) {
+				t.Error("want true for the predicate")
+			}
+			// Exactly one predicate holds.
+			preds := []func(p, q Period[tz.UTC]) bool{
+				Period[tz.UTC].Before, Period[tz.UTC].Meets, Period[tz.UTC].OverlapsStart,
+				Period[tz.UTC].Starts, Period[tz.UTC].During, Period[tz.UTC].Finishes,
+				Period[tz.UTC].Equal, Period[tz.UTC].FinishedBy, Period[tz.UTC].Encloses,
+				Period[tz.UTC].StartedBy, Period[tz.UTC].OverlapsEnd, Period[tz.UTC].MetBy,
+				Period[tz.UTC].After,
+			}
+			n := 0
+			for _, pred := range preds {
+				if pred(tc.p, q) {
+					n++
+				}
+			}
+			if n != 1 {
+				t.Errorf("want exactly one predicate but got %d", n)
+			}
+		})
+	}
+}
+
+func TestRelation_String(t *testing.T) {
+	if got := RelationOverlappedBy.String(); got != "overlapped by" {
+		t.Errorf("want overlapped by but got %q", got)
+	}
+	if got := Relation(0).String(); got != "unknown" {
+		t.Errorf("want unknown but got %q", got)
+	}
+	if got := RelationEquals.Inverse(); got != RelationEquals {
+		t.Errorf("want equals but got %s", got)
+	}
+}
@@ -0,0 +1,160 @@
+package synchro
+
+import (
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Code-Hex/synchro/locale"
+)
+
+// RelativeRounding is the rounding mode of RelativeFormatter.
+type RelativeRounding int
+
+const (
+	// RoundHalfUp rounds half away from zero, e.g. 90 minutes is "2 hours ago".
+	RoundHalfUp RelativeRounding = iota
+	// RoundDown truncates toward zero, e.g. 90 minutes is "1 hour ago".
+	RoundDown
+	// RoundUp rounds away from zero, e.g. 61 minutes is "2 hours ago".
+	RoundUp
+)
+
+func (r RelativeRounding) round(v float64) int {
+	switch r {
+	case RoundDown:
+		return int(math.Floor(v))
+	case RoundUp:
+		return int(math.Ceil(v))
+	}
+	return int(math.Round(v))
+}
+
+// RelativeThresholds determines the unit of relative time. Each field is
+// the upper limit (exclusive) of the rounded value in the unit, above which
+// the next larger unit is used.
+type RelativeThresholds struct {
+	// Now is the duration below which the relative time is formatted as now.
+	// Zero means only the durations which are rounded to zero seconds.
+	Now time.Duration
+
+	Second int
+	Minute int
+	Hour   int
+	Day    int
+	// Week is used between days and months if it is positive.
+	Week  int
+	Month int
+}
+
+// DefaultRelativeThresholds is the thresholds used for the zero fields of
+// RelativeFormatter.Thresholds. It is the same as moment.js.
+var DefaultRelativeThresholds = RelativeThresholds{
+	Second: 45,
+	Minute: 45,
+	Hour:   22,
+	Day:    26,
+	Month:  11,
+}
+
+// The approximate lengths of units larger than a day.
+const (
+	relativeDay   = 24 * time.Hour
+	relativeWeek  = 7 * relativeDay
+	relativeMonth = time.Duration(30.436875 * float64(relativeDay))
+	relativeYear  = time.Duration(365.2425 * float64(relativeDay))
+)
+
+// RelativeFormatter formats relative time such as "3 hours ago" and "in 2 days".
+//
+// The zero value formats in English with long style, DefaultRelativeThresholds
+// and RoundHalfUp.
+type RelativeFormatter struct {
+	// Locale is the locale data. Nil means locale.English.
+	Locale *locale.Locale
+
+	// Style is the width of the text.
+	Style locale.Style
+
+	// Thresholds determines the unit. The zero fields are taken from
+	// DefaultRelativeThresholds.
+	Thresholds RelativeThresholds
+
+	// Rounding is the rounding mode of the value in the unit.
+	Rounding RelativeRounding
+}
+
+// Format returns the relative time of the duration d. Positive d is in the
+// future and negative d is in the past.
+//
+// A day is 24 hours, a month is 30.436875 days and a year is 365.2425 days.
+// The duration which is rounded to zero seconds is formatted as now, and the
+// larger units are at least one.
+func (f RelativeFormatter) Format(d time.Duration) string {
+	th := f.Thresholds.withDefaults()
+	past := d < 0
+	if past {
+		d = -d
+	}
+	if d < th.Now {
+		return f.Locale.NowText()
+	}
+	in := func(unit time.Duration) int {
+		return f.Rounding.round(float64(d) / float64(unit))
+	}
+	unit, n := locale.Year, max(in(relativeYear), 1)
+	switch {
+	case in(time.Second) < th.Second:
+		unit, n = locale.Second, in(time.Second)
+	case in(time.Minute) < th.Minute:
+		unit, n = locale.Minute, max(in(time.Minute), 1)
+	case in(time.Hour) < th.Hour:
+		unit, n = locale.Hour, max(in(time.Hour), 1)
+	case in(relativeDay) < th.Day && (th.Week <= 0 || in(relativeDay) < 7):
+		unit, n = locale.Day, max(in(relativeDay), 1)
+	case th.Week > 0 && in(relativeWeek) < th.Week:
+		unit, n = locale.Week, max(in(relativeWeek), 1)
+	case in(relativeMonth) < th.Month:
+		unit, n = locale.Month, max(in(relativeMonth), 1)
+	}
+	if n == 0 {
+		return f.Locale.NowText()
+	}
+	pattern := f.Locale.RelativePattern(f.Style, unit, n, past)
+	return strings.Replace(pattern, "{0}", strconv.Itoa(n), 1)
+}
+
+// withDefaults returns th whose zero fields are replaced with
+// DefaultRelativeThresholds.
+func (th RelativeThresholds) withDefaults() RelativeThresholds {
+	def := DefaultRelativeThresholds
+	if th.Now == 0 {
+		th.Now = def.Now
+	}
+	if th.Second == 0 {
+		th.Second = def.Second
+	}
+	if th.Minute == 0 {
+		th.Minute = def.Minute
+	}
+	if th.Hour == 0 {
+		th.Hour = def.Hour
+	}
+	if th.Day == 0 {
+		th.Day = def.Day
+	}
+	if th.Week == 0 {
+		th.Week = def.Week
+	}
+	if th.Month == 0 {
+		th.Month = def.Month
+	}
+	return th
+}
+
+// FormatRelative returns the relative time of t from now, such as "3 hours ago"
+// if t is 3 hours before now.
+func (t Time[T]) FormatRelative(now Time[T], f RelativeFormatter) string {
+	return f.Format(t.Sub(now))
+}
@@ -0,0 +1,116 @@
+package synchro_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/Code-Hex/synchro"
+	"github.com/Code-Hex/synchro/locale"
+	"github.com/Code-Hex/synchro/tz"
+)
+
+func TestRelativeFormatter_Format(t *testing.T) {
+	day := 24 * time.Hour
+	tests := []struct {
+		name string
+		f    synchro.RelativeFormatter
+		d    time.Duration
+		want string
+	}{
+		{name: "zero", d: 0, want: "now"},
+		{name: "sub-second past", d: -300 * time.Millisecond, want: "now"},
+		{name: "sub-second rounded up", d: 700 * time.Millisecond, want: "in 1 second"},
+		{name: "sub-second round up", f: synchro.RelativeFormatter{Rounding: synchro.RoundUp}, d: -300 * time.Millisecond, want: "1 second ago"},
+		{name: "ja zero", f: synchro.RelativeFormatter{Locale: locale.Japanese}, d: 0, want: locale.Japanese.NowText()},
+		{name: "seconds ago", d: -30 * time.Second, want: "30 seconds ago"},
+		{name: "one second", d: time.Second, want: "in 1 second"},
+		{name: "45 seconds is a minute", d: -45 * time.Second, want: "1 minute ago"},
+		{name: "minutes", d: 10 * time.Minute, want: "in 10 minutes"},
+		{name: "45 minutes is an hour", d: -45 * time.Minute, want: "1 hour ago"},
+		{name: "rounding half up", d: -90 * time.Minute, want: "2 hours ago"},
+		{name: "rounding down", f: synchro.RelativeFormatter{Rounding: synchro.RoundDown}, d: -90 * time.Minute, want: "1 hour ago"},
+		{name: "rounding up", f: synchro.RelativeFormatter{Rounding: synchro.RoundUp}, d: -61 * time.Minute, want: "2 hours ago"},
+		{name: "days", d: 2 * day, want: "in 2 days"},
+		{name: "22 hours is a day", d: -22 * time.Hour, want: "1 day ago"},
+		{name: "months", d: -60 * day, want: "2 months ago"},
+		{name: "26 days is a month", d: 26 * day, want: "in 1 month"},
+		{name: "years", d: -800 * day, want: "2 years ago"},
+		{name: "11 months is a year", d: 335 * day, want: "in 1 year"},
+		{
+			name: "weeks",
+			f:    synchro.RelativeFormatter{Thresholds: synchro.RelativeThresholds{Second: 45, Minute: 45, Hour: 22, Day: 26, Week: 4, Month: 11}},
+			d:    -15 * day,
+			want: "2 weeks ago",
+		},
+		{
+			name: "now",
+			f:    synchro.RelativeFormatter{Thresholds: synchro.RelativeThresholds{Now: 10 * time.Second, Second: 45, Minute: 45, Hour: 22, Day: 26, Month: 11}},
+			d:    -5 * time.Second,
+			want: "now",
+		},
+		{
+			name: "partial thresholds now",
+			f:    synchro.RelativeFormatter{Thresholds: synchro.RelativeThresholds{Now: 10 * time.Second}},
+			d:    5 * time.Minute,
+			want: "in 5 minutes",
+		},
+		{
+			name: "partial thresholds week",
+			f:    synchro.RelativeFormatter{Thresholds: synchro.RelativeThresholds{Week: 4}},
+			d:    3 * time.Hour,
+			want: "in 3 hours",
+		},
+		{
+			name: "partial thresholds weeks",
+			f:    synchro.RelativeFormatter{Thresholds: synchro.RelativeThresholds{Week: 4}},
+			d:    -15 * day,
+			want: "2 weeks ago",
+		},
+		{
+			name: "partial thresholds minute",
+			f:    synchro.RelativeFormatter{Thresholds: synchro.RelativeThresholds{Minute: 90}},
+			d:    -80 * time.Minute,
+			want: "80 minutes ago",
+		},
+		{
+			name: "minute at least one",
+			f:    synchro.RelativeFormatter{Thresholds: synchro.RelativeThresholds{Second: 10}, Rounding: synchro.RoundDown},
+			d:    -20 * time.Second,
+			want: "1 minute ago",
+		},
+		{name: "short", f: synchro.RelativeFormatter{Style: locale.Short}, d: -3 * time.Hour, want: "3 hr. ago"},
+		{name: "short day", f: synchro.RelativeFormatter{Style: locale.Short}, d: day, want: "in 1 day"},
+		{name: "narrow", f: synchro.RelativeFormatter{Style: locale.Narrow}, d: 3 * time.Hour, want: "in 3h"},
+		{name: "ja long", f: synchro.RelativeFormatter{Locale: locale.Japanese}, d: -3 * time.Hour, want: "3 時間前"},
+		{name: "ja short falls back to long", f: synchro.RelativeFormatter{Locale: locale.Japanese, Style: locale.Short}, d: 2 * day, want: "2 日後"},
+		{name: "ja narrow", f: synchro.RelativeFormatter{Locale: locale.Japanese, Style: locale.Narrow}, d: -1 * time.Minute, want: "1分前"},
+		{
+			name: "ja now",
+			f:    synchro.RelativeFormatter{Locale: locale.Japanese, Thresholds: synchro.RelativeThresholds{Now: time.Minute, Second: 45, Minute: 45, Hour: 22, Day: 26, Month: 11}},
+			d:    time.Second,
+			want: "今",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.f.Format(tt.d); got != tt.want {
+				t.Errorf("want %q but got %q", tt.want, got)
+			}
+		})
+	}
+}
+
+func ExampleTime_FormatRelative() {
+	now := synchro.New[tz.AsiaTokyo](2023, 9, 2, 14, 0, 0, 0)
+	t := synchro.New[tz.AsiaTokyo](2023, 9, 2, 11, 0, 0, 0)
+	fmt.Println(t.FormatRelative(now, synchro.RelativeFormatter{}))
+	fmt.Println(t.FormatRelative(now, synchro.RelativeFormatter{Style: locale.Narrow}))
+	fmt.Println(t.FormatRelative(now, synchro.RelativeFormatter{Locale: locale.Japanese}))
+	fmt.Println(now.FormatRelative(t, synchro.RelativeFormatter{Locale: locale.Japanese}))
+	// Output:
+	// 3 hours ago
+	// 3h ago
+	// 3 時間前
+	// 3 時間後
+}
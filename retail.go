@@ -0,0 +1,418 @@
+package synchro
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/Code-Hex/synchro/iso8601"
+)
+
+// RetailPattern is the number of weeks in each of the three periods of a quarter.
+type RetailPattern int
+
+const (
+	// Pattern445 is 4, 4 and 5 weeks in each quarter.
+	Pattern445 RetailPattern = iota
+	// Pattern454 is 4, 5 and 4 weeks in each quarter.
+	Pattern454
+	// Pattern544 is 5, 4 and 4 weeks in each quarter.
+	Pattern544
+)
+
+var retailPatterns = [...][3]int{
+	Pattern445: {4, 4, 5},
+	Pattern454: {4, 5, 4},
+	Pattern544: {5, 4, 4},
+}
+
+// String returns the pattern such as "4-4-5".
+func (p RetailPattern) String() string {
+	switch p {
+	case Pattern445:
+		return "4-4-5"
+	case Pattern454:
+		return "4-5-4"
+	case Pattern544:
+		return "5-4-4"
+	}
+	return "RetailPattern(" + strconv.Itoa(int(p)) + ")"
+}
+
+// RetailCalendar defines a 52-53 week retail calendar.
+//
+// A retail year always ends on EndWeekday, either on the last EndWeekday of
+// EndMonth or on the EndWeekday nearest to the last day of EndMonth.
+// A retail year consists of 4 quarters of 13 weeks which are divided into
+// 3 periods by Pattern. In a 53-week year, the extra week is added to the last period.
+type RetailCalendar struct {
+	// Pattern is the number of weeks in each period of a quarter. Values other
+	// than Pattern445, Pattern454 and Pattern544 mean Pattern445.
+	Pattern RetailPattern
+
+	// EndMonth is the month in which the retail year ends.
+	// Zero means January.
+	EndMonth time.Month
+
+	// EndWeekday is the last weekday of the retail year.
+	EndWeekday time.Weekday
+
+	// LastOfMonth reports whether the retail year ends on the last EndWeekday
+	// of EndMonth. Otherwise, it ends on the EndWeekday nearest to the last day
+	// of EndMonth, which may be in the next month.
+	LastOfMonth bool
+
+	// NamedByEndYear reports whether the retail year is named after the calendar
+	// year in which it ends. By default, it is named after the year in which it starts.
+	NamedByEndYear bool
+}
+
+// RetailCalendarNRF is the 4-5-4 calendar of the National Retail Federation.
+// The year ends on the Saturday nearest to January 31 and is named after
+// the year in which it starts. For example, 2023 is from 2023-01-29 to 2024-02-03.
+var RetailCalendarNRF = RetailCalendar{
+	Pattern:    Pattern454,
+	EndMonth:   time.January,
+	EndWeekday: time.Saturday,
+}
+
+func (c RetailCalendar) pattern() RetailPattern {
+	if c.Pattern < Pattern445 || c.Pattern > Pattern544 {
+		return Pattern445
+	}
+	return c.Pattern
+}
+
+func (c RetailCalendar) endMonth() time.Month {
+	if c.EndMonth < time.January || c.EndMonth > time.December {
+		return time.January
+	}
+	return c.EndMonth
+}
+
+// end returns the last date of the retail year.
+func (c RetailCalendar) end(year int) iso8601.Date {
+	endYear := year
+	if !c.NamedByEndYear && c.endMonth() != time.December {
+		endYear++
+	}
+	last := iso8601.DateOf(time.Date(endYear, c.endMonth()+1, 0, 0, 0, 0, 0, time.UTC))
+	diff := (int(last.StdTime().Weekday()) - int(c.EndWeekday) + 7) % 7
+	if !c.LastOfMonth && diff > 3 {
+		return last.AddDays(7 - diff)
+	}
+	return last.AddDays(-diff)
+}
+
+// start returns the first date of the retail year.
+func (c RetailCalendar) start(year int) iso8601.Date {
+	return c.end(year - 1).AddDays(1)
+}
+
+// weeks returns the number of weeks in the retail year.
+func (c RetailCalendar) weeks(year int) int {
+	return (c.end(year).DaysSince(c.start(year)) + 1) / 7
+}
+
+// periodWeeks returns the number of weeks in the period.
+func (c RetailCalendar) periodWeeks(year, period int) int {
+	weeks := retailPatterns[c.pattern()][(period-1)%3]
+	if period == 12 && c.weeks(year) == 53 {
+		weeks++
+	}
+	return weeks
+}
+
+// locate returns the retail year, the period and the week of the date.
+func (c RetailCalendar) locate(d iso8601.Date) (year, period, week int) {
+	year = d.Year - 1
+	for c.end(year).Before(d) {
+		year++
+	}
+	week = d.DaysSince(c.start(year))/7 + 1
+	period, w := 1, week
+	for period < 12 && w > c.periodWeeks(year, period) {
+		w -= c.periodWeeks(year, period)
+		period++
+	}
+	return year, period, week
+}
+
+func retailStart[T TimeZone](d iso8601.Date) Time[T] {
+	return New[T](d.Year, d.Month, d.Day, 0, 0, 0, 0)
+}
+
+func retailEnd[T TimeZone](d iso8601.Date) Time[T] {
+	return New[T](d.Year, d.Month, d.Day, 23, 59, 59, 999999999)
+}
+
+// RetailYear is a year of RetailCalendar.
+type RetailYear[T TimeZone] struct {
+	cal  RetailCalendar
+	year int
+}
+
+// RetailYear gets the retail year in which t occurs.
+func (t Time[T]) RetailYear(cal RetailCalendar) RetailYear[T] {
+	year, _, _ := cal.locate(iso8601.DateOf(t.tm))
+	return RetailYear[T]{cal: cal, year: year}
+}
+
+// Year returns the name of the retail year.
+func (y RetailYear[T]) Year() int { return y.year }
+
+// Weeks returns the number of weeks in the retail year, which is 52 or 53.
+func (y RetailYear[T]) Weeks() int { return y.cal.weeks(y.year) }
+
+// Is53Weeks reports whether the retail year has 53 weeks.
+func (y RetailYear[T]) Is53Weeks() bool { return y.Weeks() == 53 }
+
+// Start returns start time in the retail year.
+func (y RetailYear[T]) Start() Time[T] { return retailStart[T](y.cal.start(y.year)) }
+
+// End returns end time in the retail year.
+func (y RetailYear[T]) End() Time[T] { return retailEnd[T](y.cal.end(y.year)) }
+
+// Next returns the next retail year.
+func (y RetailYear[T]) Next() RetailYear[T] { return RetailYear[T]{cal: y.cal, year: y.year + 1} }
+
+// Prev returns the previous retail year.
+func (y RetailYear[T]) Prev() RetailYear[T] { return RetailYear[T]{cal: y.cal, year: y.year - 1} }
+
+// Quarter returns the nth quarter of the retail year. n is clamped to the range 1-4.
+func (y RetailYear[T]) Quarter(n int) RetailQuarter[T] {
+	return RetailQuarter[T]{cal: y.cal, year: y.year, number: min(max(n, 1), 4)}
+}
+
+// Period returns the nth period of the retail year. n must be in range 1-12.
+func (y RetailYear[T]) Period(n int) RetailPeriod[T] {
+	return RetailPeriod[T]{cal: y.cal, year: y.year, number: n}
+}
+
+// Week returns the nth week of the retail year. n must be in range 1-52 or 1-53.
+func (y RetailYear[T]) Week(n int) RetailWeek[T] {
+	return RetailWeek[T]{cal: y.cal, year: y.year, number: n}
+}
+
+// RetailQuarter is a quarter of RetailCalendar, which consists of 3 periods.
+type RetailQuarter[T TimeZone] struct {
+	cal    RetailCalendar
+	year   int
+	number int
+}
+
+// RetailQuarter gets the retail quarter in which t occurs.
+func (t Time[T]) RetailQuarter(cal RetailCalendar) RetailQuarter[T] {
+	return t.RetailPeriod(cal).RetailQuarter()
+}
+
+// Year returns the name of the retail year in which q occurs.
+func (q RetailQuarter[T]) Year() int { return q.year }
+
+// Number returns the number of quarter in the retail year, in range 1-4.
+func (q RetailQuarter[T]) Number() int { return q.number }
+
+// Weeks returns the number of weeks in the quarter, which is 13 or 14.
+func (q RetailQuarter[T]) Weeks() int {
+	weeks := 0
+	for i := 0; i < 3; i++ {
+		weeks += q.Period(i + 1).Weeks()
+	}
+	return weeks
+}
+
+// RetailYear returns the retail year in which q occurs.
+func (q RetailQuarter[T]) RetailYear() RetailYear[T] {
+	return RetailYear[T]{cal: q.cal, year: q.year}
+}
+
+// Period returns the nth period of the quarter. n is clamped to the range 1-3.
+func (q RetailQuarter[T]) Period(n int) RetailPeriod[T] {
+	return RetailPeriod[T]{cal: q.cal, year: q.year, number: 3*(q.number-1) + min(max(n, 1), 3)}
+}
+
+// Start returns start time in the quarter.
+func (q RetailQuarter[T]) Start() Time[T] { return q.Period(1).Start() }
+
+// End returns end time in the quarter.
+func (q RetailQuarter[T]) End() Time[T] { return q.Period(3).End() }
+
+// Next returns the next quarter.
+func (q RetailQuarter[T]) Next() RetailQuarter[T] {
+	if q.number >= 4 {
+		return RetailQuarter[T]{cal: q.cal, year: q.year + 1, number: 1}
+	}
+	return RetailQuarter[T]{cal: q.cal, year: q.year, number: q.number + 1}
+}
+
+// Prev returns the previous quarter.
+func (q RetailQuarter[T]) Prev() RetailQuarter[T] {
+	if q.number <= 1 {
+		return RetailQuarter[T]{cal: q.cal, year: q.year - 1, number: 4}
+	}
+	return RetailQuarter[T]{cal: q.cal, year: q.year, number: q.number - 1}
+}
+
+// After reports whether the quarter q is after u.
+func (q RetailQuarter[T]) After(u RetailQuarter[T]) bool { return q.Compare(u) > 0 }
+
+// Before reports whether the quarter q is before u.
+func (q RetailQuarter[T]) Before(u RetailQuarter[T]) bool { return q.Compare(u) < 0 }
+
+// Compare compares the quarter q with u. If q is before u, it returns -1;
+// if q is after u, it returns +1; if they're the same, it returns 0.
+func (q RetailQuarter[T]) Compare(u RetailQuarter[T]) int {
+	return compareYearNumber(q.year, q.number, u.year, u.number)
+}
+
+// RetailPeriod is a period (retail month) of RetailCalendar.
+type RetailPeriod[T TimeZone] struct {
+	cal    RetailCalendar
+	year   int
+	number int
+}
+
+// RetailPeriod gets the retail period in which t occurs.
+func (t Time[T]) RetailPeriod(cal RetailCalendar) RetailPeriod[T] {
+	year, period, _ := cal.locate(iso8601.DateOf(t.tm))
+	return RetailPeriod[T]{cal: cal, year: year, number: period}
+}
+
+// Year returns the name of the retail year in which p occurs.
+func (p RetailPeriod[T]) Year() int { return p.year }
+
+// Number returns the number of period in the retail year, in range 1-12.
+func (p RetailPeriod[T]) Number() int { return p.number }
+
+// Weeks returns the number of weeks in the period.
+func (p RetailPeriod[T]) Weeks() int { return p.cal.periodWeeks(p.year, p.number) }
+
+// RetailYear returns the retail year in which p occurs.
+func (p RetailPeriod[T]) RetailYear() RetailYear[T] {
+	return RetailYear[T]{cal: p.cal, year: p.year}
+}
+
+// RetailQuarter returns the retail quarter in which p occurs.
+func (p RetailPeriod[T]) RetailQuarter() RetailQuarter[T] {
+	return RetailQuarter[T]{cal: p.cal, year: p.year, number: (p.number-1)/3 + 1}
+}
+
+func (p RetailPeriod[T]) firstDate() iso8601.Date {
+	weeks := 0
+	for i := 1; i < p.number; i++ {
+		weeks += p.cal.periodWeeks(p.year, i)
+	}
+	return p.cal.start(p.year).AddDays(7 * weeks)
+}
+
+// Start returns start time in the period.
+func (p RetailPeriod[T]) Start() Time[T] { return retailStart[T](p.firstDate()) }
+
+// End returns end time in the period.
+func (p RetailPeriod[T]) End() Time[T] {
+	return retailEnd[T](p.firstDate().AddDays(7*p.Weeks() - 1))
+}
+
+// Next returns the next period.
+func (p RetailPeriod[T]) Next() RetailPeriod[T] {
+	if p.number >= 12 {
+		return RetailPeriod[T]{cal: p.cal, year: p.year + 1, number: 1}
+	}
+	return RetailPeriod[T]{cal: p.cal, year: p.year, number: p.number + 1}
+}
+
+// Prev returns the previous period.
+func (p RetailPeriod[T]) Prev() RetailPeriod[T] {
+	if p.number <= 1 {
+		return RetailPeriod[T]{cal: p.cal, year: p.year - 1, number: 12}
+	}
+	return RetailPeriod[T]{cal: p.cal, year: p.year, number: p.number - 1}
+}
+
+// After reports whether the period p is after u.
+func (p RetailPeriod[T]) After(u RetailPeriod[T]) bool { return p.Compare(u) > 0 }
+
+// Before reports whether the period p is before u.
+func (p RetailPeriod[T]) Before(u RetailPeriod[T]) bool { return p.Compare(u) < 0 }
+
+// Compare compares the period p with u. If p is before u, it returns -1;
+// if p is after u, it returns +1; if they're the same, it returns 0.
+func (p RetailPeriod[T]) Compare(u RetailPeriod[T]) int {
+	return compareYearNumber(p.year, p.number, u.year, u.number)
+}
+
+// RetailWeek is a week of RetailCalendar.
+type RetailWeek[T TimeZone] struct {
+	cal    RetailCalendar
+	year   int
+	number int
+}
+
+// RetailWeek gets the retail week in which t occurs.
+func (t Time[T]) RetailWeek(cal RetailCalendar) RetailWeek[T] {
+	year, _, week := cal.locate(iso8601.DateOf(t.tm))
+	return RetailWeek[T]{cal: cal, year: year, number: week}
+}
+
+// Year returns the name of the retail year in which w occurs.
+func (w RetailWeek[T]) Year() int { return w.year }
+
+// Number returns the number of week in the retail year, in range 1-53.
+func (w RetailWeek[T]) Number() int { return w.number }
+
+// RetailPeriod returns the period in which w occurs.
+func (w RetailWeek[T]) RetailPeriod() RetailPeriod[T] {
+	_, period, _ := w.cal.locate(w.firstDate())
+	return RetailPeriod[T]{cal: w.cal, year: w.year, number: period}
+}
+
+func (w RetailWeek[T]) firstDate() iso8601.Date {
+	return w.cal.start(w.year).AddDays(7 * (w.number - 1))
+}
+
+// Start returns start time in the week.
+func (w RetailWeek[T]) Start() Time[T] { return retailStart[T](w.firstDate()) }
+
+// End returns end time in the week.
+func (w RetailWeek[T]) End() Time[T] { return retailEnd[T](w.firstDate().AddDays(6)) }
+
+// Next returns the next week.
+func (w RetailWeek[T]) Next() RetailWeek[T] {
+	if w.number >= w.cal.weeks(w.year) {
+		return RetailWeek[T]{cal: w.cal, year: w.year + 1, number: 1}
+	}
+	return RetailWeek[T]{cal: w.cal, year: w.year, number: w.number + 1}
+}
+
+// Prev returns the previous week.
+func (w RetailWeek[T]) Prev() RetailWeek[T] {
+	if w.number <= 1 {
+		return RetailWeek[T]{cal: w.cal, year: w.year - 1, number: w.cal.weeks(w.year - 1)}
+	}
+	return RetailWeek[T]{cal: w.cal, year: w.year, number: w.number - 1}
+}
+
+// After reports whether the week w is after u.
+func (w RetailWeek[T]) After(u RetailWeek[T]) bool { return w.Compare(u) > 0 }
+
+// Before reports whether the week w is before u.
+func (w RetailWeek[T]) Before(u RetailWeek[T]) bool { return w.Compare(u) < 0 }
+
+// Compare compares the week w with u. If w is before u, it returns -1;
+// if w is after u, it returns +1; if they're the same, it returns 0.
+func (w RetailWeek[T]) Compare(u RetailWeek[T]) int {
+	return compareYearNumber(w.year, w.number, u.year, u.number)
+}
+
+func compareYearNumber(year1, number1, year2, number2 int) int {
+	switch {
+	case year1 < year2:
+		return -1
+	case year1 > year2:
+		return 1
+	case number1 < number2:
+		return -1
+	case number1 > number2:
+		return 1
+	}
+	return 0
+}
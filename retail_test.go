@@ -0,0 +1,252 @@
+package synchro_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/Code-Hex/synchro"
+	"github.com/Code-Hex/synchro/tz"
+)
+
+func TestRetailYear(t *testing.T) {
+	tests := []struct {
+		name      string
+		cal       synchro.RetailCalendar
+		date      synchro.Time[tz.UTC]
+		wantYear  int
+		wantWeeks int
+		wantStart synchro.Time[tz.UTC]
+		wantEnd   synchro.Time[tz.UTC]
+	}{
+		{
+			name:      "NRF 2023 is 53 weeks",
+			cal:       synchro.RetailCalendarNRF,
+			date:      synchro.New[tz.UTC](2024, 2, 1, 12, 0, 0, 0),
+			wantYear:  2023,
+			wantWeeks: 53,
+			wantStart: synchro.New[tz.UTC](2023, 1, 29, 0, 0, 0, 0),
+			wantEnd:   synchro.New[tz.UTC](2024, 2, 3, 23, 59, 59, 999999999),
+		},
+		{
+			name:      "NRF 2024",
+			cal:       synchro.RetailCalendarNRF,
+			date:      synchro.New[tz.UTC](2024, 2, 4, 0, 0, 0, 0),
+			wantYear:  2024,
+			wantWeeks: 52,
+			wantStart: synchro.New[tz.UTC](2024, 2, 4, 0, 0, 0, 0),
+			wantEnd:   synchro.New[tz.UTC](2025, 2, 1, 23, 59, 59, 999999999),
+		},
+		{
+			name: "last Sunday of August named by end year",
+			cal: synchro.RetailCalendar{
+				Pattern:        synchro.Pattern445,
+				EndMonth:       time.August,
+				EndWeekday:     time.Sunday,
+				LastOfMonth:    true,
+				NamedByEndYear: true,
+			},
+			date:      synchro.New[tz.UTC](2023, 8, 28, 0, 0, 0, 0),
+			wantYear:  2024,
+			wantWeeks: 52,
+			wantStart: synchro.New[tz.UTC](2023, 8, 28, 0, 0, 0, 0),
+			wantEnd:   synchro.New[tz.UTC](2024, 8, 25, 23, 59, 59, 999999999),
+		},
+		{
+			name:      "December",
+			cal:       synchro.RetailCalendar{EndMonth: time.December, EndWeekday: time.Saturday},
+			date:      synchro.New[tz.UTC](2023, 12, 31, 0, 0, 0, 0),
+			wantYear:  2024,
+			wantWeeks: 52,
+			wantStart: synchro.New[tz.UTC](2023, 12, 31, 0, 0, 0, 0),
+			wantEnd:   synchro.New[tz.UTC](2024, 12, 28, 23, 59, 59, 999999999),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			y := tt.date.RetailYear(tt.cal)
+			if y.Year() != tt.wantYear {
+				t.Errorf("want year %d but got %d", tt.wantYear, y.Year())
+			}
+			if y.Weeks() != tt.wantWeeks || y.Is53Weeks() != (tt.wantWeeks == 53) {
+				t.Errorf("want %d weeks but got %d", tt.wantWeeks, y.Weeks())
+			}
+			if !tt.wantStart.Equal(y.Start()) {
+				t.Errorf("want start %s but got %s", tt.wantStart, y.Start())
+			}
+			if !tt.wantEnd.Equal(y.End()) {
+				t.Errorf("want end %s but got %s", tt.wantEnd, y.End())
+			}
+			if got := y.Next().Start(); !y.End().Add(1).Equal(got) {
+				t.Errorf("want next start %s but got %s", y.End().Add(1), got)
+			}
+			if got := y.Prev().End(); !y.Start().Add(-1).Equal(got) {
+				t.Errorf("want prev end %s but got %s", y.Start().Add(-1), got)
+			}
+
+			// Periods and weeks cover the year without gaps.
+			p := y.Period(1)
+			if !p.Start().Equal(y.Start()) {
+				t.Errorf("want first period start %s but got %s", y.Start(), p.Start())
+			}
+			weeks := 0
+			for i := 1; i <= 12; i, p = i+1, p.Next() {
+				if p.Number() != i || p.Year() != y.Year() {
+					t.Fatalf("want period %d/%d but got %d/%d", y.Year(), i, p.Year(), p.Number())
+				}
+				if !p.Next().Start().Equal(p.End().Add(1)) {
+					t.Errorf("period %d: want next start %s but got %s", i, p.End().Add(1), p.Next().Start())
+				}
+				weeks += p.Weeks()
+			}
+			if weeks != y.Weeks() {
+				t.Errorf("want %d weeks in periods but got %d", y.Weeks(), weeks)
+			}
+			if last := y.Period(12); !last.End().Equal(y.End()) {
+				t.Errorf("want last period end %s but got %s", y.End(), last.End())
+			}
+			if last := y.Week(y.Weeks()); !last.End().Equal(y.End()) || last.Next() != y.Next().Week(1) {
+				t.Errorf("want last week end %s but got %s", y.End(), last.End())
+			}
+		})
+	}
+}
+
+func TestRetailPeriod(t *testing.T) {
+	tests := []struct {
+		pattern    synchro.RetailPattern
+		wantWeeks  [3]int
+		wantPeriod int
+	}{
+		{pattern: synchro.Pattern445, wantWeeks: [3]int{4, 4, 5}, wantPeriod: 2},
+		{pattern: synchro.Pattern454, wantWeeks: [3]int{4, 5, 4}, wantPeriod: 2},
+		{pattern: synchro.Pattern544, wantWeeks: [3]int{5, 4, 4}, wantPeriod: 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.pattern.String(), func(t *testing.T) {
+			cal := synchro.RetailCalendarNRF
+			cal.Pattern = tt.pattern
+			// The 5th week of 2024 starts at 2024-03-03.
+			d := synchro.New[tz.UTC](2024, 3, 3, 0, 0, 0, 0)
+			if w := d.RetailWeek(cal); w.Year() != 2024 || w.Number() != 5 {
+				t.Errorf("want week 2024/5 but got %d/%d", w.Year(), w.Number())
+			}
+			p := d.RetailPeriod(cal)
+			if p.Number() != tt.wantPeriod || p.RetailQuarter().Number() != 1 {
+				t.Errorf("want period %d in Q1 but got %d in Q%d", tt.wantPeriod, p.Number(), p.RetailQuarter().Number())
+			}
+			if w := d.RetailWeek(cal).RetailPeriod(); w != p {
+				t.Errorf("want the period of the week %d but got %d", p.Number(), w.Number())
+			}
+			y := p.RetailYear()
+			for i, want := range tt.wantWeeks {
+				if got := y.Period(i + 1).Weeks(); got != want {
+					t.Errorf("period %d: want %d weeks but got %d", i+1, want, got)
+				}
+			}
+			if !p.Next().After(p) || !p.Prev().Before(p) || p.Compare(p) != 0 {
+				t.Error("want Next after p and Prev before p")
+			}
+		})
+	}
+
+	// The 53rd week is added to the last period.
+	y := synchro.New[tz.UTC](2023, 6, 1, 0, 0, 0, 0).RetailYear(synchro.RetailCalendarNRF)
+	if got := y.Period(12).Weeks(); got != 5 {
+		t.Errorf("want 5 weeks but got %d", got)
+	}
+	w := synchro.New[tz.UTC](2024, 2, 3, 0, 0, 0, 0).RetailWeek(synchro.RetailCalendarNRF)
+	if w.Number() != 53 || w.RetailPeriod().Number() != 12 {
+		t.Errorf("want week 53 in period 12 but got week %d in period %d", w.Number(), w.RetailPeriod().Number())
+	}
+	if got := w.Next().Prev(); got != w {
+		t.Errorf("want week %d but got %d", w.Number(), got.Number())
+	}
+}
+
+func TestRetailQuarter(t *testing.T) {
+	cal := synchro.RetailCalendarNRF
+	y := synchro.New[tz.UTC](2023, 6, 1, 0, 0, 0, 0).RetailYear(cal)
+	tests := []struct {
+		n         int
+		wantStart synchro.Time[tz.UTC]
+		wantEnd   synchro.Time[tz.UTC]
+		wantWeeks int
+	}{
+		{n: 1, wantStart: synchro.New[tz.UTC](2023, 1, 29, 0, 0, 0, 0), wantEnd: synchro.New[tz.UTC](2023, 4, 29, 23, 59, 59, 999999999), wantWeeks: 13},
+		{n: 2, wantStart: synchro.New[tz.UTC](2023, 4, 30, 0, 0, 0, 0), wantEnd: synchro.New[tz.UTC](2023, 7, 29, 23, 59, 59, 999999999), wantWeeks: 13},
+		{n: 3, wantStart: synchro.New[tz.UTC](2023, 7, 30, 0, 0, 0, 0), wantEnd: synchro.New[tz.UTC](2023, 10, 28, 23, 59, 59, 999999999), wantWeeks: 13},
+		// The 53rd week is in the last quarter.
+		{n: 4, wantStart: synchro.New[tz.UTC](2023, 10, 29, 0, 0, 0, 0), wantEnd: synchro.New[tz.UTC](2024, 2, 3, 23, 59, 59, 999999999), wantWeeks: 14},
+	}
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("Q%d", tt.n), func(t *testing.T) {
+			q := y.Quarter(tt.n)
+			if q.Year() != 2023 || q.Number() != tt.n {
+				t.Errorf("want 2023 Q%d but got %d Q%d", tt.n, q.Year(), q.Number())
+			}
+			if got := q.Start(); !tt.wantStart.Equal(got) {
+				t.Errorf("want start %s but got %s", tt.wantStart, got)
+			}
+			if got := q.End(); !tt.wantEnd.Equal(got) {
+				t.Errorf("want end %s but got %s", tt.wantEnd, got)
+			}
+			if got := q.Weeks(); got != tt.wantWeeks {
+				t.Errorf("want %d weeks but got %d", tt.wantWeeks, got)
+			}
+			if got := q.Start().RetailQuarter(cal); got != q {
+				t.Errorf("want Q%d but got Q%d", q.Number(), got.Number())
+			}
+			if got := q.End().RetailQuarter(cal); got != q {
+				t.Errorf("want Q%d but got Q%d", q.Number(), got.Number())
+			}
+			if got := q.Period(3).RetailQuarter(); got != q {
+				t.Errorf("want Q%d but got Q%d", q.Number(), got.Number())
+			}
+			if !q.Next().After(q) || !q.Prev().Before(q) || q.Compare(q) != 0 {
+				t.Error("want Next after q and Prev before q")
+			}
+			if next := q.Next(); !next.Start().Equal(q.End().Add(time.Nanosecond)) {
+				t.Errorf("want next quarter start at %s but got %s", q.End().Add(time.Nanosecond), next.Start())
+			}
+		})
+	}
+	if got := y.Quarter(4).Next(); got.Year() != 2024 || got.Number() != 1 {
+		t.Errorf("want 2024 Q1 but got %d Q%d", got.Year(), got.Number())
+	}
+	if got := y.Quarter(1).Prev(); got.Year() != 2022 || got.Number() != 4 {
+		t.Errorf("want 2022 Q4 but got %d Q%d", got.Year(), got.Number())
+	}
+	if got := y.Quarter(0); got != y.Quarter(1) {
+		t.Errorf("want Q1 but got Q%d", got.Number())
+	}
+	if got := y.Quarter(5); got != y.Quarter(4) {
+		t.Errorf("want Q4 but got Q%d", got.Number())
+	}
+}
+
+func TestRetailCalendar_InvalidPattern(t *testing.T) {
+	cal := synchro.RetailCalendarNRF
+	cal.Pattern = 3
+	d := synchro.New[tz.UTC](2024, 3, 3, 0, 0, 0, 0)
+	cal445 := cal
+	cal445.Pattern = synchro.Pattern445
+	if got, want := d.RetailPeriod(cal).Weeks(), d.RetailPeriod(cal445).Weeks(); got != want {
+		t.Errorf("want %d weeks as 4-4-5 but got %d", want, got)
+	}
+	if got, want := d.RetailPeriod(cal).Start(), d.RetailPeriod(cal445).Start(); !want.Equal(got) {
+		t.Errorf("want %s as 4-4-5 but got %s", want, got)
+	}
+}
+
+func ExampleTime_RetailPeriod() {
+	d := synchro.New[tz.UTC](2023, 12, 25, 0, 0, 0, 0)
+	p := d.RetailPeriod(synchro.RetailCalendarNRF)
+	fmt.Printf("%d P%d (Q%d, %d weeks)\n", p.Year(), p.Number(), p.RetailQuarter().Number(), p.Weeks())
+	fmt.Println(p.Start())
+	fmt.Println(p.End())
+	// Output:
+	// 2023 P11 (Q4, 5 weeks)
+	// 2023-11-26 00:00:00 +0000 UTC
+	// 2023-12-30 23:59:59.999999999 +0000 UTC
+}
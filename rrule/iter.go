@@ -0,0 +1,418 @@
+package rrule
+
+import (
+	"iter"
+	"time"
+
+	"github.com/Code-Hex/synchro"
+)
+
+// All returns an iterator over the occurrences of r starting at dtstart in
+// ascending order. dtstart is the first occurrence only if it matches r. Use
+// Set to always have DTSTART as the first occurrence as RFC 5545 specifies.
+//
+// Occurrences are computed on the wall clock in the timezone T, so "every
+// Tuesday at 10:00" stays at 10:00 local time across DST transitions. As
+// RFC 5545 specifies, an occurrence in a gap is shifted forward by the length
+// of the gap, and an occurrence in an overlap is the first (earliest) one.
+// Sub-daily frequencies also step on the wall clock.
+//
+// If neither Count nor Until is set, the iterator is infinite up to year 9999.
+// The iterator also stops if r has no occurrence in the periods of 400 years
+// in a row, as a rule such as "BYMONTH=2;BYMONTHDAY=30" never matches.
+func All[T synchro.TimeZone](r Rule, dtstart synchro.Time[T]) iter.Seq[synchro.Time[T]] {
+	return func(yield func(synchro.Time[T]) bool) {
+		var (
+			until    synchro.Time[T]
+			hasUntil = !r.Until.IsZero()
+		)
+		if hasUntil {
+			if r.untilFloating {
+				until = synchro.ResolveLocal[T](synchro.LocalDateTimeOf(r.Until))
+			} else {
+				until = synchro.In[T](r.Until)
+			}
+		}
+		e := newEvaluator(r, dtstart.LocalDateTime().UTC())
+		count := 0
+		var last synchro.Time[T]
+		for e.next() {
+			for _, c := range e.candidates {
+				t := synchro.ResolveLocal[T](synchro.LocalDateTimeOf(c))
+				if t.Before(dtstart) || (count > 0 && !t.After(last)) {
+					continue
+				}
+				if hasUntil && t.After(until) {
+					return
+				}
+				if !yield(t) {
+					return
+				}
+				last = t
+				count++
+				if r.Count > 0 && count >= r.Count {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Between returns the occurrences of r starting at dtstart which are within
+// the range from from to to, inclusive.
+func Between[T synchro.TimeZone](r Rule, dtstart, from, to synchro.Time[T]) []synchro.Time[T] {
+	var ret []synchro.Time[T]
+	for t := range All(r, dtstart) {
+		if t.After(to) {
+			break
+		}
+		if !t.Before(from) {
+			ret = append(ret, t)
+		}
+	}
+	return ret
+}
+
+// maxEmptyPeriods bounds the periods in a row which have no occurrences. The
+// Gregorian calendar repeats every 400 years, so a daily or longer rule which
+// has no occurrence in the periods of 400 years never matches. Sub-daily rules
+// skip the days, hours and minutes which are not allowed, and are bounded as
+// daily ones.
+var maxEmptyPeriods = [...]int{
+	Yearly:   400,
+	Monthly:  400 * 12,
+	Weekly:   400 * 53,
+	Daily:    400 * 366,
+	Hourly:   400 * 366,
+	Minutely: 400 * 366,
+	Secondly: 400 * 366,
+}
+
+// evaluator expands a rule period by period on the wall clock.
+// All times in evaluator are wall clock times represented in UTC.
+type evaluator struct {
+	r          Rule
+	start      time.Time
+	cursor     time.Time
+	candidates []time.Time
+	empty      int // the periods in a row which have no candidates
+
+	hours, minutes, seconds []int
+}
+
+func newEvaluator(r Rule, start time.Time) *evaluator {
+	if r.Interval < 1 {
+		r.Interval = 1
+	}
+	if len(r.ByWeekNo) == 0 && len(r.ByYearDay) == 0 && len(r.ByMonthDay) == 0 && len(r.ByDay) == 0 {
+		switch r.Freq {
+		case Yearly:
+			if len(r.ByMonth) == 0 {
+				r.ByMonth = []int{int(start.Month())}
+			}
+			r.ByMonthDay = []int{start.Day()}
+		case Monthly:
+			r.ByMonthDay = []int{start.Day()}
+		case Weekly:
+			r.ByDay = []Weekday{{Weekday: start.Weekday()}}
+		}
+	}
+	e := &evaluator{
+		r:       r,
+		start:   start,
+		hours:   defaultInts(r.ByHour, start.Hour()),
+		minutes: defaultInts(r.ByMinute, start.Minute()),
+		seconds: defaultInts(r.BySecond, start.Second()),
+	}
+	switch r.Freq {
+	case Yearly:
+		e.cursor = time.Date(start.Year(), 1, 1, 0, 0, 0, 0, time.UTC)
+	case Monthly:
+		e.cursor = time.Date(start.Year(), start.Month(), 1, 0, 0, 0, 0, time.UTC)
+	case Weekly:
+		e.cursor = e.weekStart(start.Truncate(24 * time.Hour))
+	case Daily:
+		e.cursor = start.Truncate(24 * time.Hour)
+	case Hourly:
+		e.cursor = start.Truncate(time.Hour)
+	case Minutely:
+		e.cursor = start.Truncate(time.Minute)
+	default:
+		e.cursor = start.Truncate(time.Second)
+	}
+	return e
+}
+
+func defaultInts(s []int, v int) []int {
+	if len(s) == 0 {
+		return []int{v}
+	}
+	return sortedInts(s)
+}
+
+// next computes the candidates of the period at the cursor and advances
+// the cursor. It reports false if the period is out of the supported range,
+// or there are too many periods in a row without candidates.
+func (e *evaluator) next() bool {
+	if e.cursor.Year() > 9999 || e.empty >= maxEmptyPeriods[e.r.Freq] {
+		return false
+	}
+	e.candidates = e.candidates[:0]
+	if e.skip() {
+		e.empty++
+		return true
+	}
+	times := e.times()
+	if len(times) > 0 {
+		for _, d := range e.days() {
+			if !e.matchDay(d) {
+				continue
+			}
+			for _, tm := range times {
+				e.candidates = append(e.candidates, d.Add(tm))
+			}
+		}
+	}
+	if len(e.r.BySetPos) > 0 {
+		e.candidates = setPos(e.candidates, e.r.BySetPos)
+	}
+	if len(e.candidates) == 0 {
+		e.empty++
+	} else {
+		e.empty = 0
+	}
+	e.advance()
+	return true
+}
+
+// skip advances the cursor of a sub-daily rule to the first period of the next
+// day, hour or minute if the current one is not allowed by the rule. It
+// reports whether the cursor is advanced.
+func (e *evaluator) skip() bool {
+	var next time.Time
+	switch {
+	case e.r.Freq >= Hourly && !e.matchDay(e.cursor.Truncate(24*time.Hour)):
+		next = e.cursor.Truncate(24*time.Hour).AddDate(0, 0, 1)
+	case e.r.Freq >= Minutely && len(e.r.ByHour) > 0 && !contains(e.r.ByHour, e.cursor.Hour()):
+		next = e.cursor.Truncate(time.Hour).Add(time.Hour)
+	case e.r.Freq >= Secondly && len(e.r.ByMinute) > 0 && !contains(e.r.ByMinute, e.cursor.Minute()):
+		next = e.cursor.Truncate(time.Minute).Add(time.Minute)
+	default:
+		return false
+	}
+	// Keep the cursor on the steps of the interval.
+	step := e.step()
+	e.cursor = e.cursor.Add((next.Sub(e.cursor) + step - 1) / step * step)
+	return true
+}
+
+// step returns the length of a period of a sub-daily rule.
+func (e *evaluator) step() time.Duration {
+	n := time.Duration(e.r.Interval)
+	switch e.r.Freq {
+	case Hourly:
+		return n * time.Hour
+	case Minutely:
+		return n * time.Minute
+	}
+	return n * time.Second
+}
+
+func (e *evaluator) advance() {
+	n := e.r.Interval
+	switch e.r.Freq {
+	case Yearly:
+		e.cursor = e.cursor.AddDate(n, 0, 0)
+	case Monthly:
+		e.cursor = e.cursor.AddDate(0, n, 0)
+	case Weekly:
+		e.cursor = e.cursor.AddDate(0, 0, 7*n)
+	case Daily:
+		e.cursor = e.cursor.AddDate(0, 0, n)
+	default:
+		e.cursor = e.cursor.Add(e.step())
+	}
+}
+
+// days returns the dates in the period at the cursor.
+func (e *evaluator) days() []time.Time {
+	var from, to time.Time
+	switch e.r.Freq {
+	case Yearly:
+		year := e.cursor.Year()
+		if len(e.r.ByWeekNo) > 0 {
+			from, to = weekOneStart(year, e.r.WeekStart), weekOneStart(year+1, e.r.WeekStart)
+		} else {
+			from, to = e.cursor, e.cursor.AddDate(1, 0, 0)
+		}
+	case Monthly:
+		from, to = e.cursor, e.cursor.AddDate(0, 1, 0)
+	case Weekly:
+		from, to = e.cursor, e.cursor.AddDate(0, 0, 7)
+	default:
+		from = e.cursor.Truncate(24 * time.Hour)
+		to = from.AddDate(0, 0, 1)
+	}
+	var ret []time.Time
+	for d := from; d.Before(to); d = d.AddDate(0, 0, 1) {
+		ret = append(ret, d)
+	}
+	return ret
+}
+
+// times returns the times of day in the period at the cursor.
+func (e *evaluator) times() []time.Duration {
+	hours, minutes, seconds := e.hours, e.minutes, e.seconds
+	if e.r.Freq >= Hourly {
+		hours = fixed(e.r.ByHour, e.cursor.Hour())
+	}
+	if e.r.Freq >= Minutely {
+		minutes = fixed(e.r.ByMinute, e.cursor.Minute())
+	}
+	if e.r.Freq >= Secondly {
+		seconds = fixed(e.r.BySecond, e.cursor.Second())
+	}
+	nsec := time.Duration(e.start.Nanosecond())
+	var ret []time.Duration
+	for _, h := range hours {
+		for _, m := range minutes {
+			for _, s := range seconds {
+				ret = append(ret, time.Duration(h)*time.Hour+time.Duration(m)*time.Minute+time.Duration(s)*time.Second+nsec)
+			}
+		}
+	}
+	return ret
+}
+
+// fixed returns v if it is allowed by the limit.
+func fixed(limit []int, v int) []int {
+	if len(limit) == 0 || contains(limit, v) {
+		return []int{v}
+	}
+	return nil
+}
+
+func (e *evaluator) matchDay(d time.Time) bool {
+	r := e.r
+	if len(r.ByMonth) > 0 && !contains(r.ByMonth, int(d.Month())) {
+		return false
+	}
+	if len(r.ByWeekNo) > 0 {
+		week, weeks := weekNo(d, r.WeekStart)
+		if !matchIndex(r.ByWeekNo, week, weeks) {
+			return false
+		}
+	}
+	if len(r.ByYearDay) > 0 && !matchIndex(r.ByYearDay, d.YearDay(), daysInYear(d.Year())) {
+		return false
+	}
+	if len(r.ByMonthDay) > 0 && !matchIndex(r.ByMonthDay, d.Day(), daysInMonth(d.Year(), d.Month())) {
+		return false
+	}
+	if len(r.ByDay) > 0 && !e.matchWeekday(d) {
+		return false
+	}
+	return true
+}
+
+// matchWeekday reports whether d matches BYDAY. The ordinal is relative to
+// the month for MONTHLY or YEARLY with BYMONTH, and to the year for YEARLY.
+// Otherwise, the ordinal is ignored.
+func (e *evaluator) matchWeekday(d time.Time) bool {
+	r := e.r
+	monthly := r.Freq == Monthly || (r.Freq == Yearly && len(r.ByMonth) > 0)
+	yearly := r.Freq == Yearly && !monthly && len(r.ByWeekNo) == 0
+	for _, wd := range r.ByDay {
+		if d.Weekday() != wd.Weekday {
+			continue
+		}
+		var day, days int
+		switch {
+		case wd.N == 0:
+			return true
+		case monthly:
+			day, days = d.Day(), daysInMonth(d.Year(), d.Month())
+		case yearly:
+			day, days = d.YearDay(), daysInYear(d.Year())
+		default:
+			return true
+		}
+		n := (day-1)/7 + 1
+		total := (day-1)/7 + (days-day)/7 + 1
+		if wd.N == n || wd.N == n-total-1 {
+			return true
+		}
+	}
+	return false
+}
+
+// setPos returns the elements of s at the 1-based positions. Negative positions
+// count from the end.
+func setPos(s []time.Time, positions []int) []time.Time {
+	ret := make([]time.Time, 0, len(positions))
+	for i, t := range s {
+		if matchIndex(positions, i+1, len(s)) {
+			ret = append(ret, t)
+		}
+	}
+	return ret
+}
+
+// weekStart returns the start of the week in which d occurs.
+func (e *evaluator) weekStart(d time.Time) time.Time {
+	return d.AddDate(0, 0, -((int(d.Weekday()) - int(e.r.WeekStart) + 7) % 7))
+}
+
+// weekOneStart returns the start of the first week of the year, which is the
+// first week containing at least 4 days of the year.
+func weekOneStart(year int, wkst time.Weekday) time.Time {
+	jan4 := time.Date(year, 1, 4, 0, 0, 0, 0, time.UTC)
+	return jan4.AddDate(0, 0, -((int(jan4.Weekday()) - int(wkst) + 7) % 7))
+}
+
+// weekNo returns the week number of d and the number of weeks in its week-numbering year.
+func weekNo(d time.Time, wkst time.Weekday) (week, weeks int) {
+	year := d.Year()
+	start := weekOneStart(year, wkst)
+	if d.Before(start) {
+		year--
+		start = weekOneStart(year, wkst)
+	} else if next := weekOneStart(year+1, wkst); !d.Before(next) {
+		year++
+		start = next
+	}
+	end := weekOneStart(year+1, wkst)
+	return daysBetween(start, d)/7 + 1, daysBetween(start, end) / 7
+}
+
+func daysBetween(from, to time.Time) int {
+	return int(to.Sub(from) / (24 * time.Hour))
+}
+
+// matchIndex reports whether v, which is 1-based in n, is in s. Negative
+// elements of s count from the end.
+func matchIndex(s []int, v, n int) bool {
+	for _, x := range s {
+		if x == v || (x < 0 && n+x+1 == v) {
+			return true
+		}
+	}
+	return false
+}
+
+func contains(s []int, v int) bool {
+	for _, x := range s {
+		if x == v {
+			return true
+		}
+	}
+	return false
+}
+
+func daysInMonth(year int, month time.Month) int {
+	return time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
+
+func daysInYear(year int) int {
+	return time.Date(year, 12, 31, 0, 0, 0, 0, time.UTC).YearDay()
+}
@@ -0,0 +1,305 @@
+// Package rrule implements the recurrence rule (RRULE) of iCalendar as
+// defined in RFC 5545, evaluated in the timezone T of synchro.Time[T].
+package rrule
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Code-Hex/synchro/iso8601"
+)
+
+// Frequency is the FREQ rule part which identifies the type of recurrence rule.
+type Frequency int
+
+const (
+	Yearly Frequency = iota
+	Monthly
+	Weekly
+	Daily
+	Hourly
+	Minutely
+	Secondly
+)
+
+var frequencies = [...]string{
+	Yearly:   "YEARLY",
+	Monthly:  "MONTHLY",
+	Weekly:   "WEEKLY",
+	Daily:    "DAILY",
+	Hourly:   "HOURLY",
+	Minutely: "MINUTELY",
+	Secondly: "SECONDLY",
+}
+
+// String returns the name of the frequency used in RRULE, e.g. "WEEKLY".
+func (f Frequency) String() string {
+	if f < Yearly || f > Secondly {
+		return fmt.Sprintf("Frequency(%d)", int(f))
+	}
+	return frequencies[f]
+}
+
+// Weekday is an element of the BYDAY rule part.
+//
+// N is the ordinal of the weekday within the month or the year, such as
+// 2 for "2TU" (the second Tuesday) or -1 for "-1FR" (the last Friday).
+// Zero means every weekday in the period.
+type Weekday struct {
+	Weekday time.Weekday
+	N       int
+}
+
+var weekdays = [...]string{
+	time.Sunday:    "SU",
+	time.Monday:    "MO",
+	time.Tuesday:   "TU",
+	time.Wednesday: "WE",
+	time.Thursday:  "TH",
+	time.Friday:    "FR",
+	time.Saturday:  "SA",
+}
+
+// String returns the representation used in RRULE, e.g. "2TU".
+func (w Weekday) String() string {
+	if w.N == 0 {
+		return weekdays[w.Weekday]
+	}
+	return strconv.Itoa(w.N) + weekdays[w.Weekday]
+}
+
+// Rule is a recurrence rule.
+//
+// The zero value of WeekStart is Sunday, while the default of WKST in RFC 5545
+// is Monday. Parse sets WeekStart to Monday if WKST is omitted.
+type Rule struct {
+	Freq     Frequency
+	Interval int // Interval less than 1 is treated as 1.
+
+	// Count limits the number of occurrences. Zero means no limit.
+	Count int
+
+	// Until bounds the occurrences inclusively. The zero value means no bound.
+	// Until parsed from a value without the "Z" suffix is a floating time which
+	// is interpreted in the timezone of the start time.
+	Until time.Time
+
+	WeekStart  time.Weekday
+	ByMonth    []int
+	ByWeekNo   []int
+	ByYearDay  []int
+	ByMonthDay []int
+	ByDay      []Weekday
+	ByHour     []int
+	ByMinute   []int
+	BySecond   []int
+	BySetPos   []int
+
+	untilFloating bool
+}
+
+// Parse parses a recurrence rule such as "FREQ=MONTHLY;BYDAY=2TU".
+// The "RRULE:" prefix is optional.
+func Parse(s string) (Rule, error) {
+	s = strings.TrimPrefix(strings.TrimSpace(s), "RRULE:")
+	r := Rule{
+		Interval:  1,
+		WeekStart: time.Monday,
+	}
+	seenFreq := false
+	for _, part := range strings.Split(s, ";") {
+		name, value, ok := strings.Cut(part, "=")
+		if !ok {
+			return Rule{}, fmt.Errorf("rrule: invalid rule part %q", part)
+		}
+		var err error
+		switch strings.ToUpper(name) {
+		case "FREQ":
+			r.Freq, err = parseFrequency(value)
+			seenFreq = true
+		case "INTERVAL":
+			r.Interval, err = parsePositive(name, value)
+		case "COUNT":
+			r.Count, err = parsePositive(name, value)
+		case "UNTIL":
+			r.Until, r.untilFloating, err = parseUntil(value)
+		case "WKST":
+			r.WeekStart, err = parseWeekdayName(value)
+		case "BYMONTH":
+			r.ByMonth, err = parseInts(name, value, 1, 12, false)
+		case "BYWEEKNO":
+			r.ByWeekNo, err = parseInts(name, value, 1, 53, true)
+		case "BYYEARDAY":
+			r.ByYearDay, err = parseInts(name, value, 1, 366, true)
+		case "BYMONTHDAY":
+			r.ByMonthDay, err = parseInts(name, value, 1, 31, true)
+		case "BYDAY":
+			r.ByDay, err = parseWeekdays(value)
+		case "BYHOUR":
+			r.ByHour, err = parseInts(name, value, 0, 23, false)
+		case "BYMINUTE":
+			r.ByMinute, err = parseInts(name, value, 0, 59, false)
+		case "BYSECOND":
+			r.BySecond, err = parseInts(name, value, 0, 60, false)
+		case "BYSETPOS":
+			r.BySetPos, err = parseInts(name, value, 1, 366, true)
+		default:
+			return Rule{}, fmt.Errorf("rrule: unknown rule part %q", name)
+		}
+		if err != nil {
+			return Rule{}, err
+		}
+	}
+	if !seenFreq {
+		return Rule{}, fmt.Errorf("rrule: FREQ is required: %q", s)
+	}
+	if r.Count > 0 && !r.Until.IsZero() {
+		return Rule{}, fmt.Errorf("rrule: COUNT and UNTIL must not occur in the same rule: %q", s)
+	}
+	return r, nil
+}
+
+func parseFrequency(s string) (Frequency, error) {
+	for f, name := range frequencies {
+		if strings.EqualFold(s, name) {
+			return Frequency(f), nil
+		}
+	}
+	return 0, fmt.Errorf("rrule: unknown FREQ %q", s)
+}
+
+func parsePositive(name, s string) (int, error) {
+	v, err := strconv.Atoi(s)
+	if err != nil || v < 1 {
+		return 0, fmt.Errorf("rrule: %s must be a positive integer: %q", name, s)
+	}
+	return v, nil
+}
+
+func parseInts(name, s string, min, max int, signed bool) ([]int, error) {
+	var ret []int
+	for _, elem := range strings.Split(s, ",") {
+		v, err := strconv.Atoi(elem)
+		if err != nil {
+			return nil, fmt.Errorf("rrule: invalid %s value %q", name, elem)
+		}
+		abs := v
+		if signed && v < 0 {
+			abs = -v
+		}
+		if abs < min || abs > max {
+			return nil, fmt.Errorf("rrule: %s value %d is not in range %d-%d", name, v, min, max)
+		}
+		ret = append(ret, v)
+	}
+	return ret, nil
+}
+
+func parseWeekdayName(s string) (time.Weekday, error) {
+	for wd, name := range weekdays {
+		if strings.EqualFold(s, name) {
+			return time.Weekday(wd), nil
+		}
+	}
+	return 0, fmt.Errorf("rrule: unknown weekday %q", s)
+}
+
+func parseWeekdays(s string) ([]Weekday, error) {
+	var ret []Weekday
+	for _, elem := range strings.Split(s, ",") {
+		if len(elem) < 2 {
+			return nil, fmt.Errorf("rrule: invalid BYDAY value %q", elem)
+		}
+		wd, err := parseWeekdayName(elem[len(elem)-2:])
+		if err != nil {
+			return nil, err
+		}
+		n := 0
+		if ordinal := elem[:len(elem)-2]; ordinal != "" {
+			n, err = strconv.Atoi(ordinal)
+			if err != nil || n == 0 || n < -53 || n > 53 {
+				return nil, fmt.Errorf("rrule: invalid BYDAY value %q", elem)
+			}
+		}
+		ret = append(ret, Weekday{Weekday: wd, N: n})
+	}
+	return ret, nil
+}
+
+// parseUntil parses the UNTIL value which is either a date or a date-time.
+// A date is treated as the end of the day.
+func parseUntil(s string) (time.Time, bool, error) {
+	if strings.HasSuffix(s, "Z") {
+		tm, err := iso8601.ParseDateTime(s, iso8601.WithInLocation(time.UTC))
+		if err != nil {
+			return time.Time{}, false, fmt.Errorf("rrule: invalid UNTIL: %w", err)
+		}
+		return tm, false, nil
+	}
+	tm, err := iso8601.ParseDateTime(s, iso8601.WithInLocation(time.UTC))
+	if err != nil {
+		return time.Time{}, false, fmt.Errorf("rrule: invalid UNTIL: %w", err)
+	}
+	if !strings.Contains(s, "T") {
+		tm = tm.AddDate(0, 0, 1).Add(-time.Nanosecond)
+	}
+	return tm, true, nil
+}
+
+// String returns the rule in the format of RRULE without the "RRULE:" prefix.
+func (r Rule) String() string {
+	var b strings.Builder
+	b.WriteString("FREQ=")
+	b.WriteString(r.Freq.String())
+	if r.Interval > 1 {
+		fmt.Fprintf(&b, ";INTERVAL=%d", r.Interval)
+	}
+	if r.Count > 0 {
+		fmt.Fprintf(&b, ";COUNT=%d", r.Count)
+	}
+	if !r.Until.IsZero() {
+		if r.untilFloating {
+			b.WriteString(";UNTIL=" + r.Until.Format("20060102T150405"))
+		} else {
+			b.WriteString(";UNTIL=" + r.Until.UTC().Format("20060102T150405Z"))
+		}
+	}
+	if r.WeekStart != time.Monday {
+		b.WriteString(";WKST=" + weekdays[r.WeekStart])
+	}
+	writeInts := func(name string, values []int) {
+		if len(values) == 0 {
+			return
+		}
+		s := make([]string, len(values))
+		for i, v := range values {
+			s[i] = strconv.Itoa(v)
+		}
+		b.WriteString(";" + name + "=" + strings.Join(s, ","))
+	}
+	writeInts("BYMONTH", r.ByMonth)
+	writeInts("BYWEEKNO", r.ByWeekNo)
+	writeInts("BYYEARDAY", r.ByYearDay)
+	writeInts("BYMONTHDAY", r.ByMonthDay)
+	if len(r.ByDay) > 0 {
+		s := make([]string, len(r.ByDay))
+		for i, wd := range r.ByDay {
+			s[i] = wd.String()
+		}
+		b.WriteString(";BYDAY=" + strings.Join(s, ","))
+	}
+	writeInts("BYHOUR", r.ByHour)
+	writeInts("BYMINUTE", r.ByMinute)
+	writeInts("BYSECOND", r.BySecond)
+	writeInts("BYSETPOS", r.BySetPos)
+	return b.String()
+}
+
+func sortedInts(s []int) []int {
+	ret := append([]int(nil), s...)
+	sort.Ints(ret)
+	return ret
+}
@@ -0,0 +1,364 @@
+package rrule_test
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/Code-Hex/synchro"
+	"github.com/Code-Hex/synchro/rrule"
+	"github.com/Code-Hex/synchro/tz"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		value   string
+		want    string
+		wantErr bool
+	}{
+		{value: "FREQ=DAILY", want: "FREQ=DAILY"},
+		{value: "RRULE:FREQ=MONTHLY;INTERVAL=2;BYDAY=2TU,-1FR", want: "FREQ=MONTHLY;INTERVAL=2;BYDAY=2TU,-1FR"},
+		{value: "FREQ=YEARLY;BYMONTH=1,2;BYMONTHDAY=-1;COUNT=3", want: "FREQ=YEARLY;COUNT=3;BYMONTH=1,2;BYMONTHDAY=-1"},
+		{value: "FREQ=WEEKLY;UNTIL=20231231T235959Z;WKST=SU", want: "FREQ=WEEKLY;UNTIL=20231231T235959Z;WKST=SU"},
+		{value: "FREQ=WEEKLY;UNTIL=20231231T100000", want: "FREQ=WEEKLY;UNTIL=20231231T100000"},
+		{value: "FREQ=MONTHLY;BYDAY=MO,TU,WE,TH,FR;BYSETPOS=-1", want: "FREQ=MONTHLY;BYDAY=MO,TU,WE,TH,FR;BYSETPOS=-1"},
+		{value: "INTERVAL=2", wantErr: true},
+		{value: "FREQ=FORTNIGHTLY", wantErr: true},
+		{value: "FREQ=DAILY;INTERVAL=0", wantErr: true},
+		{value: "FREQ=DAILY;COUNT=2;UNTIL=20231231T235959Z", wantErr: true},
+		{value: "FREQ=MONTHLY;BYMONTHDAY=32", wantErr: true},
+		{value: "FREQ=MONTHLY;BYDAY=0MO", wantErr: true},
+		{value: "FREQ=MONTHLY;BYDAY=XX", wantErr: true},
+		{value: "FREQ=MONTHLY;FOO=1", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			got, err := rrule.Parse(tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("want error %v but got %v", tt.wantErr, err)
+			}
+			if tt.wantErr {
+				return
+			}
+			if got.String() != tt.want {
+				t.Errorf("want %s but got %s", tt.want, got.String())
+			}
+		})
+	}
+}
+
+func TestAll(t *testing.T) {
+	tests := []struct {
+		rule    string
+		dtstart string
+		want    []string
+	}{
+		{
+			rule:    "FREQ=DAILY;COUNT=3",
+			dtstart: "1997-09-02T09:00:00",
+			want:    []string{"1997-09-02T09:00:00", "1997-09-03T09:00:00", "1997-09-04T09:00:00"},
+		},
+		{
+			rule:    "FREQ=DAILY;INTERVAL=10;COUNT=3",
+			dtstart: "1997-09-02T09:00:00",
+			want:    []string{"1997-09-02T09:00:00", "1997-09-12T09:00:00", "1997-09-22T09:00:00"},
+		},
+		{
+			rule:    "FREQ=WEEKLY;UNTIL=19971007T000000Z;WKST=SU;BYDAY=TU,TH",
+			dtstart: "1997-09-02T09:00:00",
+			want: []string{
+				"1997-09-02T09:00:00", "1997-09-04T09:00:00", "1997-09-09T09:00:00", "1997-09-11T09:00:00",
+				"1997-09-16T09:00:00", "1997-09-18T09:00:00", "1997-09-23T09:00:00", "1997-09-25T09:00:00",
+				"1997-09-30T09:00:00", "1997-10-02T09:00:00",
+			},
+		},
+		{
+			rule:    "FREQ=MONTHLY;COUNT=6;BYDAY=1FR,-1FR",
+			dtstart: "1997-09-05T09:00:00",
+			want: []string{
+				"1997-09-05T09:00:00", "1997-09-26T09:00:00", "1997-10-03T09:00:00",
+				"1997-10-31T09:00:00", "1997-11-07T09:00:00", "1997-11-28T09:00:00",
+			},
+		},
+		{
+			rule:    "FREQ=MONTHLY;BYMONTHDAY=-3;COUNT=3",
+			dtstart: "1997-09-28T09:00:00",
+			want:    []string{"1997-09-28T09:00:00", "1997-10-29T09:00:00", "1997-11-28T09:00:00"},
+		},
+		{
+			// The last work day of the month.
+			rule:    "FREQ=MONTHLY;BYDAY=MO,TU,WE,TH,FR;BYSETPOS=-1;COUNT=3",
+			dtstart: "1997-09-29T09:00:00",
+			want:    []string{"1997-09-30T09:00:00", "1997-10-31T09:00:00", "1997-11-28T09:00:00"},
+		},
+		{
+			// Monday of week number 20.
+			rule:    "FREQ=YEARLY;BYWEEKNO=20;BYDAY=MO;COUNT=3",
+			dtstart: "1997-05-12T09:00:00",
+			want:    []string{"1997-05-12T09:00:00", "1998-05-11T09:00:00", "1999-05-17T09:00:00"},
+		},
+		{
+			// The 20th Monday of the year.
+			rule:    "FREQ=YEARLY;BYDAY=20MO;COUNT=3",
+			dtstart: "1997-05-19T09:00:00",
+			want:    []string{"1997-05-19T09:00:00", "1998-05-18T09:00:00", "1999-05-17T09:00:00"},
+		},
+		{
+			// US Presidential Election day.
+			rule:    "FREQ=YEARLY;INTERVAL=4;BYMONTH=11;BYDAY=TU;BYMONTHDAY=2,3,4,5,6,7,8;COUNT=3",
+			dtstart: "1996-11-05T09:00:00",
+			want:    []string{"1996-11-05T09:00:00", "2000-11-07T09:00:00", "2004-11-02T09:00:00"},
+		},
+		{
+			rule:    "FREQ=YEARLY;COUNT=3",
+			dtstart: "2020-02-29T09:00:00",
+			want:    []string{"2020-02-29T09:00:00", "2024-02-29T09:00:00", "2028-02-29T09:00:00"},
+		},
+		{
+			rule:    "FREQ=HOURLY;INTERVAL=3;UNTIL=19970902T170000",
+			dtstart: "1997-09-02T09:00:00",
+			want:    []string{"1997-09-02T09:00:00", "1997-09-02T12:00:00", "1997-09-02T15:00:00"},
+		},
+		{
+			rule:    "FREQ=DAILY;BYHOUR=9,10;BYMINUTE=0,30;COUNT=5",
+			dtstart: "1997-09-02T09:00:00",
+			want: []string{
+				"1997-09-02T09:00:00", "1997-09-02T09:30:00", "1997-09-02T10:00:00",
+				"1997-09-02T10:30:00", "1997-09-03T09:00:00",
+			},
+		},
+		{
+			// WKST is significant for WEEKLY with INTERVAL > 1.
+			rule:    "FREQ=WEEKLY;INTERVAL=2;COUNT=4;BYDAY=TU,SU;WKST=SU",
+			dtstart: "1997-08-05T09:00:00",
+			want:    []string{"1997-08-05T09:00:00", "1997-08-17T09:00:00", "1997-08-19T09:00:00", "1997-08-31T09:00:00"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.rule, func(t *testing.T) {
+			r, err := rrule.Parse(tt.rule)
+			if err != nil {
+				t.Fatal(err)
+			}
+			local, err := synchro.ParseLocalDateTime(tt.dtstart)
+			if err != nil {
+				t.Fatal(err)
+			}
+			dtstart, _ := synchro.LocalIn[tz.UTC](local).Single()
+			var got []string
+			for tm := range rrule.All(r, dtstart) {
+				got = append(got, tm.LocalDateTime().String())
+				if len(got) > 100 {
+					t.Fatal("too many occurrences")
+				}
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("(-want, +got)\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestAll_DST(t *testing.T) {
+	type NY = tz.AmericaNew_York
+	// Every 2nd Tuesday at 10:00 stays at 10:00 across the DST transition.
+	r, err := rrule.Parse("FREQ=MONTHLY;BYDAY=2TU;COUNT=3")
+	if err != nil {
+		t.Fatal(err)
+	}
+	dtstart := synchro.New[NY](2023, 2, 14, 10, 0, 0, 0)
+	var got []string
+	for tm := range rrule.All(r, dtstart) {
+		got = append(got, tm.Format(time.RFC3339))
+	}
+	want := []string{"2023-02-14T10:00:00-05:00", "2023-03-14T10:00:00-04:00", "2023-04-11T10:00:00-04:00"}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("(-want, +got)\n%s", diff)
+	}
+
+	// 02:30 does not exist on 2023-03-12 and is shifted forward.
+	r, err = rrule.Parse("FREQ=DAILY;COUNT=3")
+	if err != nil {
+		t.Fatal(err)
+	}
+	got = got[:0]
+	for tm := range rrule.All(r, synchro.New[NY](2023, 3, 11, 2, 30, 0, 0)) {
+		got = append(got, tm.Format(time.RFC3339))
+	}
+	want = []string{"2023-03-11T02:30:00-05:00", "2023-03-12T03:30:00-04:00", "2023-03-13T02:30:00-04:00"}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("(-want, +got)\n%s", diff)
+	}
+}
+
+func TestAll_Break(t *testing.T) {
+	r, err := rrule.Parse("FREQ=SECONDLY")
+	if err != nil {
+		t.Fatal(err)
+	}
+	n := 0
+	for range rrule.All(r, synchro.New[tz.UTC](2023, 1, 1, 0, 0, 0, 0)) {
+		n++
+		if n == 10 {
+			break
+		}
+	}
+	if n != 10 {
+		t.Errorf("want 10 but got %d", n)
+	}
+}
+
+func TestAll_NeverMatches(t *testing.T) {
+	rules := []string{
+		"FREQ=YEARLY;BYMONTH=2;BYMONTHDAY=30;COUNT=1",
+		"FREQ=DAILY;BYMONTH=2;BYMONTHDAY=30;COUNT=1",
+		"FREQ=HOURLY;BYMONTH=4;BYMONTHDAY=31;COUNT=1",
+		"FREQ=MINUTELY;BYYEARDAY=366;BYMONTH=1;COUNT=1",
+		"FREQ=SECONDLY;BYMONTH=2;BYMONTHDAY=30;COUNT=1",
+		"FREQ=MINUTELY;INTERVAL=2;BYMINUTE=1;COUNT=1",
+		"FREQ=SECONDLY;INTERVAL=2;BYSECOND=1;COUNT=1",
+	}
+	dtstart := synchro.New[tz.UTC](2023, 1, 1, 0, 0, 0, 0)
+	for _, rule := range rules {
+		t.Run(rule, func(t *testing.T) {
+			r, err := rrule.Parse(rule)
+			if err != nil {
+				t.Fatal(err)
+			}
+			start := time.Now()
+			if got := slices.Collect(rrule.All(r, dtstart)); len(got) != 0 {
+				t.Errorf("want no occurrences but got %v", got)
+			}
+			if got := rrule.Between(r, dtstart, dtstart, synchro.New[tz.UTC](9999, 1, 1, 0, 0, 0, 0)); len(got) != 0 {
+				t.Errorf("want no occurrences between but got %v", got)
+			}
+			if elapsed := time.Since(start); elapsed > 5*time.Second {
+				t.Errorf("took %s", elapsed)
+			}
+		})
+	}
+
+	// Sparse rules still match after many empty periods.
+	r, err := rrule.Parse("FREQ=SECONDLY;BYMONTH=2;BYMONTHDAY=29;BYHOUR=12;BYMINUTE=30;BYSECOND=15;COUNT=2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := slices.Collect(rrule.All(r, dtstart))
+	want := []synchro.Time[tz.UTC]{
+		synchro.New[tz.UTC](2024, 2, 29, 12, 30, 15, 0),
+		synchro.New[tz.UTC](2028, 2, 29, 12, 30, 15, 0),
+	}
+	if len(got) != len(want) {
+		t.Fatalf("want %v but got %v", want, got)
+	}
+	for i := range want {
+		if !want[i].Equal(got[i]) {
+			t.Errorf("[%d] want %s but got %s", i, want[i], got[i])
+		}
+	}
+}
+
+func TestSet(t *testing.T) {
+	set, err := rrule.ParseSet[tz.AsiaTokyo](`
+DTSTART;TZID=Asia/Tokyo:20230904T100000
+RRULE:FREQ=WEEKLY;BYDAY=MO;COUNT=4
+RDATE:20230906T010000Z,20230904T100000
+EXDATE;TZID=Asia/Tokyo:20230918T100000
+`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := slices.Collect(set.All())
+	want := []synchro.Time[tz.AsiaTokyo]{
+		synchro.New[tz.AsiaTokyo](2023, 9, 4, 10, 0, 0, 0),
+		synchro.New[tz.AsiaTokyo](2023, 9, 6, 10, 0, 0, 0),
+		synchro.New[tz.AsiaTokyo](2023, 9, 11, 10, 0, 0, 0),
+		synchro.New[tz.AsiaTokyo](2023, 9, 25, 10, 0, 0, 0),
+	}
+	if len(got) != len(want) {
+		t.Fatalf("want %v but got %v", want, got)
+	}
+	for i := range want {
+		if !want[i].Equal(got[i]) {
+			t.Errorf("[%d] want %s but got %s", i, want[i], got[i])
+		}
+	}
+
+	between := set.Between(
+		synchro.New[tz.AsiaTokyo](2023, 9, 5, 0, 0, 0, 0),
+		synchro.New[tz.AsiaTokyo](2023, 9, 11, 10, 0, 0, 0),
+	)
+	if len(between) != 2 {
+		t.Errorf("want 2 occurrences but got %v", between)
+	}
+
+	if _, err := rrule.ParseSet[tz.UTC]("RRULE:FREQ=DAILY"); err == nil {
+		t.Error("want error without DTSTART")
+	}
+}
+
+func TestSet_DTStartCounts(t *testing.T) {
+	tests := []struct {
+		set  string
+		want []string
+	}{
+		{
+			// 2023-09-05 is Tuesday, which does not match the rule.
+			set: "DTSTART:20230905T100000\nRRULE:FREQ=WEEKLY;BYDAY=MO;COUNT=3",
+			want: []string{
+				"2023-09-05T10:00:00",
+				"2023-09-11T10:00:00",
+				"2023-09-18T10:00:00",
+			},
+		},
+		{
+			set: "DTSTART:20230904T100000\nRRULE:FREQ=WEEKLY;BYDAY=MO;COUNT=3",
+			want: []string{
+				"2023-09-04T10:00:00",
+				"2023-09-11T10:00:00",
+				"2023-09-18T10:00:00",
+			},
+		},
+		{
+			set:  "DTSTART:20230905T100000\nRRULE:FREQ=WEEKLY;BYDAY=MO;COUNT=1",
+			want: []string{"2023-09-05T10:00:00"},
+		},
+		{
+			set: "DTSTART:20230905T100000\nRRULE:FREQ=WEEKLY;BYDAY=MO;COUNT=2\nRRULE:FREQ=WEEKLY;BYDAY=WE;COUNT=2",
+			want: []string{
+				"2023-09-05T10:00:00",
+				"2023-09-06T10:00:00",
+				"2023-09-11T10:00:00",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.set, func(t *testing.T) {
+			set, err := rrule.ParseSet[tz.UTC](tt.set)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var got []string
+			for tm := range set.All() {
+				got = append(got, tm.Format("2006-01-02T15:04:05"))
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("(-want, +got)\n%s", diff)
+			}
+		})
+	}
+}
+
+func ExampleAll() {
+	type NY = tz.AmericaNew_York
+	r, _ := rrule.Parse("FREQ=MONTHLY;INTERVAL=1;BYDAY=2TU;COUNT=3")
+	for t := range rrule.All(r, synchro.New[NY](2023, 2, 14, 10, 0, 0, 0)) {
+		fmt.Println(t)
+	}
+	// Output:
+	// 2023-02-14 10:00:00 -0500 EST
+	// 2023-03-14 10:00:00 -0400 EDT
+	// 2023-04-11 10:00:00 -0400 EDT
+}
@@ -0,0 +1,197 @@
+package rrule
+
+import (
+	"fmt"
+	"iter"
+	"sort"
+	"strings"
+
+	"github.com/Code-Hex/synchro"
+)
+
+// Set is a recurrence set which combines recurrence rules with the RDATE and
+// EXDATE properties of RFC 5545.
+type Set[T synchro.TimeZone] struct {
+	DTStart synchro.Time[T]
+	RRules  []Rule
+	RDates  []synchro.Time[T]
+	ExDates []synchro.Time[T]
+}
+
+// ParseSet parses the DTSTART, RRULE, RDATE and EXDATE properties separated by
+// newlines, such as:
+//
+//	DTSTART;TZID=America/New_York:20230912T100000
+//	RRULE:FREQ=MONTHLY;BYDAY=2TU
+//	EXDATE;TZID=America/New_York:20231010T100000
+//
+// Date-time values without the "Z" suffix are wall clock times in T, and
+// the TZID parameter is ignored. Values of RDATE and EXDATE can be separated
+// by commas.
+func ParseSet[T synchro.TimeZone](s string) (Set[T], error) {
+	var set Set[T]
+	seenStart := false
+	for _, line := range strings.Split(s, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		head, value, ok := strings.Cut(line, ":")
+		if !ok {
+			return Set[T]{}, fmt.Errorf("rrule: invalid property %q", line)
+		}
+		name, _, _ := strings.Cut(head, ";")
+		switch strings.ToUpper(name) {
+		case "DTSTART":
+			t, err := parseDateTime[T](value)
+			if err != nil {
+				return Set[T]{}, err
+			}
+			set.DTStart = t
+			seenStart = true
+		case "RRULE":
+			r, err := Parse(value)
+			if err != nil {
+				return Set[T]{}, err
+			}
+			set.RRules = append(set.RRules, r)
+		case "RDATE", "EXDATE":
+			for _, v := range strings.Split(value, ",") {
+				t, err := parseDateTime[T](v)
+				if err != nil {
+					return Set[T]{}, err
+				}
+				if strings.EqualFold(name, "RDATE") {
+					set.RDates = append(set.RDates, t)
+				} else {
+					set.ExDates = append(set.ExDates, t)
+				}
+			}
+		default:
+			return Set[T]{}, fmt.Errorf("rrule: unknown property %q", name)
+		}
+	}
+	if !seenStart {
+		return Set[T]{}, fmt.Errorf("rrule: DTSTART is required")
+	}
+	return set, nil
+}
+
+func parseDateTime[T synchro.TimeZone](s string) (synchro.Time[T], error) {
+	if strings.HasSuffix(s, "Z") {
+		t, err := synchro.ParseISO[T](s)
+		if err != nil {
+			return synchro.Time[T]{}, fmt.Errorf("rrule: invalid date-time %q: %w", s, err)
+		}
+		return t, nil
+	}
+	l, err := synchro.ParseLocalDateTime(s)
+	if err != nil {
+		return synchro.Time[T]{}, fmt.Errorf("rrule: invalid date-time %q: %w", s, err)
+	}
+	return synchro.ResolveLocal[T](l), nil
+}
+
+// All returns an iterator over the occurrences of the set in ascending order.
+//
+// The occurrences are DTStart, the occurrences of every RRules and RDates,
+// excluding ExDates. Duplicated occurrences are yielded once. As RFC 5545
+// specifies, DTStart is the first occurrence of every RRules, so it counts
+// toward Count even if it does not match the rule, unlike All.
+func (s Set[T]) All() iter.Seq[synchro.Time[T]] {
+	return func(yield func(synchro.Time[T]) bool) {
+		rdates := append([]synchro.Time[T]{s.DTStart}, s.RDates...)
+		sort.Slice(rdates, func(i, j int) bool { return rdates[i].Before(rdates[j]) })
+
+		type source struct {
+			next func() (synchro.Time[T], bool)
+			head synchro.Time[T]
+			ok   bool
+		}
+		sources := make([]*source, 0, len(s.RRules)+1)
+		for _, r := range s.RRules {
+			next, stop := iter.Pull(All(r, s.DTStart))
+			defer stop()
+			if r.Count > 0 {
+				next = s.countFrom(r.Count, next)
+			}
+			sources = append(sources, &source{next: next})
+		}
+		i := 0
+		sources = append(sources, &source{next: func() (synchro.Time[T], bool) {
+			if i >= len(rdates) {
+				return synchro.Time[T]{}, false
+			}
+			i++
+			return rdates[i-1], true
+		}})
+		for _, src := range sources {
+			src.head, src.ok = src.next()
+		}
+
+		var last synchro.Time[T]
+		yielded := false
+		for {
+			var min *source
+			for _, src := range sources {
+				if src.ok && (min == nil || src.head.Before(min.head)) {
+					min = src
+				}
+			}
+			if min == nil {
+				return
+			}
+			t := min.head
+			min.head, min.ok = min.next()
+			if (yielded && !t.After(last)) || s.excluded(t) {
+				continue
+			}
+			if !yield(t) {
+				return
+			}
+			last, yielded = t, true
+		}
+	}
+}
+
+// countFrom limits the occurrences from next to count including DTStart. If
+// the first occurrence is not DTStart, DTStart takes one of them.
+func (s Set[T]) countFrom(count int, next func() (synchro.Time[T], bool)) func() (synchro.Time[T], bool) {
+	first := true
+	return func() (synchro.Time[T], bool) {
+		t, ok := next()
+		if first && ok && !t.Equal(s.DTStart) {
+			count--
+		}
+		first = false
+		if !ok || count <= 0 {
+			return synchro.Time[T]{}, false
+		}
+		count--
+		return t, true
+	}
+}
+
+func (s Set[T]) excluded(t synchro.Time[T]) bool {
+	for _, ex := range s.ExDates {
+		if ex.Equal(t) {
+			return true
+		}
+	}
+	return false
+}
+
+// Between returns the occurrences of the set which are within the range from
+// from to to, inclusive.
+func (s Set[T]) Between(from, to synchro.Time[T]) []synchro.Time[T] {
+	var ret []synchro.Time[T]
+	for t := range s.All() {
+		if t.After(to) {
+			break
+		}
+		if !t.Before(from) {
+			ret = append(ret, t)
+		}
+	}
+	return ret
+}
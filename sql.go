@@ -3,6 +3,7 @@ package synchro
 import (
 	"database/sql/driver"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/Code-Hex/synchro/iso8601"
@@ -76,3 +77,148 @@ func (n NullTime[T]) Value() (driver.Value, error) {
 	}
 	return n.Time.tm, nil
 }
+
+// Scan implements the sql.Scanner interface.
+func (d *Date[T]) Scan(src any) error {
+	switch s := src.(type) {
+	case nil:
+		*d = Date[T]{} // zero value
+		return nil
+	case time.Time:
+		*d = Date[T]{d: iso8601.DateOf(s)}
+		return nil
+	case string:
+		return d.UnmarshalText([]byte(s))
+	case []byte:
+		return d.UnmarshalText(s)
+	default:
+		return fmt.Errorf("unknown type of: %T", s)
+	}
+}
+
+// Value implements the driver.Valuer interface.
+func (d Date[T]) Value() (driver.Value, error) {
+	return d.String(), nil
+}
+
+// Scan implements the sql.Scanner interface.
+//
+// The period is expected to be a text literal of PostgreSQL range types such as
+// tstzrange, for example `["2023-01-01 00:00:00+09","2023-02-01 00:00:00+09")`.
+// A missing bound, "infinity" and "-infinity" make the side unbounded, and
+// "empty" is the empty period.
+func (p *Period[T]) Scan(src any) error {
+	switch s := src.(type) {
+	case nil:
+		*p = Period[T]{} // zero value
+		return nil
+	case string:
+		return p.scanRange(s)
+	case []byte:
+		return p.scanRange(string(s))
+	default:
+		return fmt.Errorf("unknown type of: %T", s)
+	}
+}
+
+func (p *Period[T]) scanRange(s string) error {
+	s = strings.TrimSpace(s)
+	if strings.EqualFold(s, "empty") {
+		*p = Period[T]{bounds: BoundsClosedOpen}
+		return nil
+	}
+	if len(s) < 2 {
+		return fmt.Errorf("invalid range: %q", s)
+	}
+	bounds, err := ParseBounds(s[:1] + s[len(s)-1:])
+	if err != nil {
+		return fmt.Errorf("invalid range: %q", s)
+	}
+	lower, rest, err := cutRangeBound(s[1 : len(s)-1])
+	if err != nil || !strings.HasPrefix(rest, ",") {
+		return fmt.Errorf("invalid range: %q", s)
+	}
+	upper, rest, err := cutRangeBound(rest[1:])
+	if err != nil || rest != "" {
+		return fmt.Errorf("invalid range: %q", s)
+	}
+	var period Period[T]
+	if period.from, period.fromUnbounded, err = scanRangeBound[T](lower); err != nil {
+		return err
+	}
+	if period.to, period.toUnbounded, err = scanRangeBound[T](upper); err != nil {
+		return err
+	}
+	*p = period.WithBounds(bounds)
+	return nil
+}
+
+// cutRangeBound cuts a bound of a range literal, which is quoted by '"' or
+// ends before ',', from s. In the quoted bound, '"' and '\' are escaped by
+// '\' or '"' is doubled.
+func cutRangeBound(s string) (bound, rest string, err error) {
+	if !strings.HasPrefix(s, `"`) {
+		i := strings.IndexByte(s, ',')
+		if i < 0 {
+			return s, "", nil
+		}
+		return s[:i], s[i:], nil
+	}
+	var b strings.Builder
+	for i := 1; i < len(s); i++ {
+		switch c := s[i]; {
+		case c == '\\' && i+1 < len(s):
+			i++
+			b.WriteByte(s[i])
+		case c == '"' && i+1 < len(s) && s[i+1] == '"':
+			i++
+			b.WriteByte('"')
+		case c == '"':
+			return b.String(), s[i+1:], nil
+		default:
+			b.WriteByte(c)
+		}
+	}
+	return "", "", fmt.Errorf("unterminated quoted bound: %q", s)
+}
+
+func scanRangeBound[T TimeZone](s string) (Time[T], bool, error) {
+	s = strings.TrimSpace(s)
+	switch strings.ToLower(s) {
+	case "", "infinity", "-infinity":
+		return Time[T]{}, true, nil
+	}
+	var t Time[T]
+	if err := t.Scan(s); err != nil {
+		return Time[T]{}, false, err
+	}
+	return t, false, nil
+}
+
+// Value implements the driver.Valuer interface.
+//
+// The period is a text literal of PostgreSQL range types in the format
+// `["2023-01-01 00:00:00+09:00","2023-02-01 00:00:00+09:00")`. The endpoints are
+// written in chronological order, even if from is after to.
+func (p Period[T]) Value() (driver.Value, error) {
+	if p.IsEmpty() {
+		return "empty", nil
+	}
+	lo, hi := p.lower(), p.upper()
+	b := make([]byte, 0, len(`["2006-01-02 15:04:05.999999999-07:00","2006-01-02 15:04:05.999999999-07:00")`))
+	b = append(b, boundsOf(lo.incl, hi.incl).String()[0])
+	b = appendRangeBound(b, lo)
+	b = append(b, ',')
+	b = appendRangeBound(b, hi)
+	b = append(b, boundsOf(lo.incl, hi.incl).String()[1])
+	return string(b), nil
+}
+
+func appendRangeBound[T TimeZone](b []byte, bd bound[T]) []byte {
+	if bd.inf != 0 {
+		return b
+	}
+	b = append(b, '"')
+	b = bd.t.tm.AppendFormat(b, "2006-01-02 15:04:05.999999999-07:00")
+	return append(b, '"')
+}
@@ -20,6 +20,16 @@ var _ interface {
 	driver.Valuer
 } = (*synchro.NullTime[tz.UTC])(nil)
 
+var _ interface {
+	sql.Scanner
+	driver.Valuer
+} = (*synchro.Date[tz.UTC])(nil)
+
+var _ interface {
+	sql.Scanner
+	driver.Valuer
+} = (*synchro.Period[tz.UTC])(nil)
+
 func TestTime_Scan(t *testing.T) {
 	t.Run("UTC", func(t *testing.T) {
 		tests := []struct {
@@ -303,3 +313,224 @@ func TestNullTime_Value(t *testing.T) {
 	})
 
 }
+
+func TestDate_Scan(t *testing.T) {
+	tests := []struct {
+		name string
+		src  any
+		want synchro.Date[tz.AsiaTokyo]
+		err  bool
+	}{
+		{
+			name: "nil",
+			src:  nil,
+			want: synchro.Date[tz.AsiaTokyo]{},
+		},
+		{
+			name: "time.Time",
+			src:  time.Date(2023, 9, 10, 0, 0, 0, 0, time.UTC),
+			want: synchro.NewDate[tz.AsiaTokyo](2023, 9, 10),
+		},
+		{
+			name: "date as string",
+			src:  "2023-09-10",
+			want: synchro.NewDate[tz.AsiaTokyo](2023, 9, 10),
+		},
+		{
+			name: "date as bytes",
+			src:  []byte("2023-09-10"),
+			want: synchro.NewDate[tz.AsiaTokyo](2023, 9, 10),
+		},
+		{
+			name: "invalid date",
+			src:  "2023-09-31",
+			err:  true,
+		},
+		{
+			name: "unknown type",
+			src:  1,
+			err:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got synchro.Date[tz.AsiaTokyo]
+			err := got.Scan(tt.src)
+			if (err != nil) != tt.err {
+				t.Fatalf("want error %v but got %v", tt.err, err)
+			}
+			if !got.Equal(tt.want) {
+				t.Errorf("want %s but got %s", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestDate_Value(t *testing.T) {
+	d := synchro.NewDate[tz.AsiaTokyo](2023, 9, 10)
+	got, err := d.Value()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "2023-09-10"; got != want {
+		t.Errorf("want %v but got %v", want, got)
+	}
+}
+
+func TestPeriod_Scan(t *testing.T) {
+	from := synchro.New[tz.AsiaTokyo](2023, 1, 1, 0, 0, 0, 0)
+	to := synchro.New[tz.AsiaTokyo](2023, 2, 1, 0, 0, 0, 0)
+	period, err := synchro.NewPeriod[tz.AsiaTokyo](from, to)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name string
+		src  any
+		want synchro.Period[tz.AsiaTokyo]
+		err  bool
+	}{
+		{
+			name: "nil",
+			src:  nil,
+			want: synchro.Period[tz.AsiaTokyo]{},
+		},
+		{
+			name: "closed-open",
+			src:  `["2023-01-01 00:00:00+09","2023-02-01 00:00:00+09")`,
+			want: period.WithBounds(synchro.BoundsClosedOpen),
+		},
+		{
+			name: "bytes",
+			src:  []byte(`["2023-01-01 00:00:00+09","2023-02-01 00:00:00+09")`),
+			want: period.WithBounds(synchro.BoundsClosedOpen),
+		},
+		{
+			name: "open-closed in UTC",
+			src:  `("2022-12-31 15:00:00+00","2023-01-31 15:00:00+00"]`,
+			want: period.WithBounds(synchro.BoundsOpenClosed),
+		},
+		{
+			name: "unquoted",
+			src:  `[2023-01-01 00:00:00+09,2023-02-01 00:00:00+09]`,
+			want: period,
+		},
+		{
+			name: "unbounded upper",
+			src:  `["2023-01-01 00:00:00+09",)`,
+			want: synchro.PeriodSince(from),
+		},
+		{
+			name: "infinity lower",
+			src:  `[-infinity,"2023-02-01 00:00:00+09"]`,
+			want: synchro.PeriodUntil(to),
+		},
+		{
+			name: "empty",
+			src:  "empty",
+			want: synchro.Period[tz.AsiaTokyo]{}.WithBounds(synchro.BoundsClosedOpen),
+		},
+		{
+			name: "invalid bounds",
+			src:  `{"2023-01-01 00:00:00+09","2023-02-01 00:00:00+09"}`,
+			err:  true,
+		},
+		{
+			name: "missing comma",
+			src:  `["2023-01-01 00:00:00+09"]`,
+			err:  true,
+		},
+		{
+			name: "unterminated quote",
+			src:  `["2023-01-01 00:00:00+09,)`,
+			err:  true,
+		},
+		{
+			name: "invalid time",
+			src:  `["unknown",)`,
+			err:  true,
+		},
+		{
+			name: "unknown type",
+			src:  1,
+			err:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got synchro.Period[tz.AsiaTokyo]
+			err := got.Scan(tt.src)
+			if (err != nil) != tt.err {
+				t.Fatalf("want error %v but got %v", tt.err, err)
+			}
+			if tt.err {
+				return
+			}
+			if !got.Equal(tt.want) || got.IsEmpty() != tt.want.IsEmpty() {
+				t.Errorf("want %s but got %s", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestPeriod_Value(t *testing.T) {
+	from := synchro.New[tz.AsiaTokyo](2023, 1, 1, 0, 0, 0, 0)
+	to := synchro.New[tz.AsiaTokyo](2023, 2, 1, 0, 0, 0, 0)
+	period, err := synchro.NewPeriod[tz.AsiaTokyo](from, to)
+	if err != nil {
+		t.Fatal(err)
+	}
+	reversed, err := synchro.NewPeriod[tz.AsiaTokyo](to, from)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name   string
+		period synchro.Period[tz.AsiaTokyo]
+		want   string
+	}{
+		{
+			name:   "closed-open",
+			period: period.WithBounds(synchro.BoundsClosedOpen),
+			want:   `["2023-01-01 00:00:00+09:00","2023-02-01 00:00:00+09:00")`,
+		},
+		{
+			name:   "reversed",
+			period: reversed.WithBounds(synchro.BoundsClosedOpen),
+			want:   `("2023-01-01 00:00:00+09:00","2023-02-01 00:00:00+09:00"]`,
+		},
+		{
+			name:   "unbounded",
+			period: synchro.PeriodUntil(to.Add(time.Millisecond)),
+			want:   `(,"2023-02-01 00:00:00.001+09:00"]`,
+		},
+		{
+			name:   "closed",
+			period: period,
+			want:   `["2023-01-01 00:00:00+09:00","2023-02-01 00:00:00+09:00"]`,
+		},
+		{
+			name:   "empty",
+			period: synchro.Period[tz.AsiaTokyo]{}.WithBounds(synchro.BoundsOpen),
+			want:   "empty",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.period.Value()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("want %v but got %v", tt.want, got)
+			}
+			var scanned synchro.Period[tz.AsiaTokyo]
+			if err := scanned.Scan(got); err != nil {
+				t.Fatal(err)
+			}
+			if !scanned.Equal(tt.period) && !(scanned.IsEmpty() && tt.period.IsEmpty()) {
+				t.Errorf("want %s but got %s", tt.period, scanned)
+			}
+		})
+	}
+}
@@ -0,0 +1,284 @@
+package synchro
+
+import (
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/Code-Hex/synchro/locale"
+	"github.com/itchyny/timefmt-go"
+)
+
+// StrftimeLocale formats the time according to the given format string like
+// Strftime, but the names of %a, %A, %b, %h, %B, %p and %P (and the ones in
+// %c, %+, %v and %r) are taken from the locale l. Nil l means locale.English.
+//
+// The flags and width of these specifiers work as Strftime. The width is
+// counted in characters.
+//
+//	t.StrftimeLocale("%Y年%B%-d日(%a)", locale.Japanese) // 2023年9月4日(月)
+func (t Time[T]) StrftimeLocale(format string, l *locale.Locale) string {
+	return timefmt.Format(t.StdTime(), localizeFormat(t.StdTime(), format, l))
+}
+
+// StrptimeLocale parses time string with the default location like Strptime,
+// but %a, %A, %b, %h, %B, %p and %P (and the ones in %c, %+, %v and %r) accept
+// the names of the locale l. Nil l means locale.English.
+//
+// Names are matched case-insensitively. %b, %h and %B accept both the full and
+// the abbreviated month names, and %a and %A accept both the full and the
+// abbreviated weekday names.
+func StrptimeLocale[T TimeZone](source string, format string, l *locale.Locale) (Time[T], error) {
+	format = expandCompositions(format)
+	return Strptime[T](delocalizeSource(source, format, l), format)
+}
+
+// compositions is the specifiers which are composed of other specifiers.
+// It is the same as timefmt-go.
+var compositions = map[byte]string{
+	'c': "%a %b %e %H:%M:%S %Y",
+	'+': "%a %b %e %H:%M:%S %Z %Y",
+	'F': "%Y-%m-%d",
+	'D': "%m/%d/%y",
+	'x': "%m/%d/%y",
+	'v': "%e-%b-%Y",
+	'T': "%H:%M:%S",
+	'X': "%H:%M:%S",
+	'r': "%I:%M:%S %p",
+	'R': "%H:%M",
+}
+
+// localizeFormat replaces the specifiers of names in format with the localized
+// names, so that the result can be formatted by timefmt-go.
+func localizeFormat(t time.Time, format string, l *locale.Locale) string {
+	var b strings.Builder
+	for i := 0; i < len(format); i++ {
+		if format[i] != '%' {
+			b.WriteByte(format[i])
+			continue
+		}
+		j := i + 1
+		for j < len(format) && strings.IndexByte("-_^#0123456789", format[j]) >= 0 {
+			j++
+		}
+		if j == len(format) {
+			b.WriteString(format[i:])
+			break
+		}
+		flags := format[i+1 : j]
+		switch c := format[j]; c {
+		case 'a', 'A':
+			b.WriteString(escapeFormat(formatName(l.WeekdayName(t.Weekday(), c == 'a'), flags, false)))
+		case 'b', 'h', 'B':
+			b.WriteString(escapeFormat(formatName(l.MonthName(t.Month(), c != 'B'), flags, false)))
+		case 'p', 'P':
+			b.WriteString(escapeFormat(formatName(l.DayPeriod(t.Hour() >= 12), flags, c == 'P')))
+		case 'c', '+', 'v', 'r':
+			// As timefmt-go, only the upper flag takes effect on compositions.
+			composition := compositions[c]
+			if strings.IndexByte(flags, '^') >= 0 {
+				composition = strings.ReplaceAll(composition, "%", "%^")
+			}
+			b.WriteString(localizeFormat(t, composition, l))
+		default:
+			b.WriteString(format[i : j+1])
+		}
+		i = j
+	}
+	return b.String()
+}
+
+// formatName applies the flags of a specifier to the name. lower reports
+// whether the name is lowercased by default, which is the case of %P.
+func formatName(name, flags string, lower bool) string {
+	var width int
+	var upper, swap bool
+	padding := " "
+	for i := 0; i < len(flags); i++ {
+		switch c := flags[i]; {
+		case '1' <= c && c <= '9', c == '0' && width > 0:
+			width = width*10 + int(c-'0')
+		case c == '-':
+			padding = ""
+		case c == '_':
+			padding = " "
+		case c == '0':
+			padding = "0"
+		case c == '^':
+			upper = true
+		case c == '#':
+			swap = true
+		}
+	}
+	switch {
+	case upper:
+		name = strings.ToUpper(name)
+	case lower, swap && name == strings.ToUpper(name):
+		name = strings.ToLower(name)
+	case swap:
+		name = strings.ToUpper(name)
+	}
+	if n := utf8.RuneCountInString(name); padding != "" && width > n {
+		name = strings.Repeat(padding, width-n) + name
+	}
+	return name
+}
+
+func escapeFormat(s string) string {
+	return strings.ReplaceAll(s, "%", "%%")
+}
+
+// expandCompositions expands the compositions in format, so that
+// delocalizeSource can find the specifiers of names in them.
+func expandCompositions(format string) string {
+	if !strings.Contains(format, "%") {
+		return format
+	}
+	var b strings.Builder
+	for i := 0; i < len(format); i++ {
+		if format[i] != '%' || i+1 == len(format) {
+			b.WriteByte(format[i])
+			continue
+		}
+		i++
+		if composition, ok := compositions[format[i]]; ok {
+			b.WriteString(composition)
+		} else {
+			b.WriteByte('%')
+			b.WriteByte(format[i])
+		}
+	}
+	return b.String()
+}
+
+// numberWidths is the maximum number of digits of the numeric specifiers
+// accepted by timefmt-go.
+var numberWidths = map[byte]int{
+	'Y': 4, 'y': 2, 'C': 2, 'g': 2, 'G': 4, 'm': 2, 'd': 2, 'e': 2, 'j': 3,
+	'k': 2, 'H': 2, 'l': 2, 'I': 2, 'M': 2, 'S': 2, 's': 10, 'f': 6,
+	'w': 1, 'u': 1, 'V': 2, 'U': 2, 'W': 2,
+}
+
+// delocalizeSource replaces the localized names in source with the English
+// names, so that the result can be parsed by timefmt-go with format. The
+// compositions in format must be expanded.
+//
+// If source does not match format, the rest of source is left as it is, and
+// timefmt-go reports the error.
+func delocalizeSource(source, format string, l *locale.Locale) string {
+	var b strings.Builder
+	j := 0
+	for i := 0; i < len(format); i++ {
+		if format[i] != '%' || i+1 == len(format) {
+			if j == len(source) || source[j] != format[i] {
+				break
+			}
+			b.WriteByte(source[j])
+			j++
+			continue
+		}
+		i++
+		start := j
+		switch c := format[i]; c {
+		case 'a', 'A':
+			n, w, ok := matchName(source[j:], 7, func(k int, abbr bool) string {
+				return l.WeekdayName(time.Weekday(k), abbr)
+			})
+			if !ok {
+				break
+			}
+			b.WriteString(locale.English.WeekdayName(time.Weekday(n), c == 'a'))
+			j += w
+			continue
+		case 'b', 'h', 'B':
+			n, w, ok := matchName(source[j:], 12, func(k int, abbr bool) string {
+				return l.MonthName(time.Month(k+1), abbr)
+			})
+			if !ok {
+				break
+			}
+			b.WriteString(locale.English.MonthName(time.Month(n+1), c != 'B'))
+			j += w
+			continue
+		case 'p', 'P':
+			n, w, ok := matchName(source[j:], 2, func(k int, _ bool) string {
+				return l.DayPeriod(k == 1)
+			})
+			if !ok {
+				break
+			}
+			b.WriteString(locale.English.DayPeriod(n == 1))
+			j += w
+			continue
+		case 'e', 'k', 'l':
+			if j < len(source) && source[j] == ' ' {
+				j++
+			}
+			j = skipDigits(source, j, numberWidths[c])
+		case 'Z':
+			for j < len(source) && 'A' <= source[j] && source[j] <= 'Z' {
+				j++
+			}
+		case ':', 'z':
+			for i < len(format) && format[i] == ':' {
+				i++
+			}
+			if j < len(source) && source[j] == 'Z' {
+				j++
+			} else if j < len(source) && (source[j] == '+' || source[j] == '-') {
+				j = skipDigits(source, j+1, 2)
+				if j < len(source) && source[j] != ':' {
+					j = skipDigits(source, j, 2)
+				}
+				for k := 0; k < 2 && j+1 < len(source) && source[j] == ':' && isDigit(source[j+1]); k++ {
+					j = skipDigits(source, j+1, 2)
+				}
+			}
+		case 't', 'n':
+			for j < len(source) && strings.IndexByte(" \t\n\v\f\r", source[j]) >= 0 {
+				j++
+			}
+		case '%':
+			if j < len(source) && source[j] == '%' {
+				j++
+			}
+		default:
+			w, ok := numberWidths[c]
+			if !ok {
+				break
+			}
+			j = skipDigits(source, j, w)
+		}
+		if j == start && format[i] != 't' && format[i] != 'n' {
+			break
+		}
+		b.WriteString(source[start:j])
+	}
+	b.WriteString(source[j:])
+	return b.String()
+}
+
+// matchName finds the longest name at the start of s case-insensitively from
+// the full and the abbreviated names given by name, and returns the index and
+// the length of the matched name.
+func matchName(s string, n int, name func(k int, abbr bool) string) (index, width int, ok bool) {
+	for k := 0; k < n; k++ {
+		for _, abbr := range []bool{false, true} {
+			v := name(k, abbr)
+			if len(v) > width && len(v) <= len(s) && strings.EqualFold(s[:len(v)], v) {
+				index, width, ok = k, len(v), true
+			}
+		}
+	}
+	return index, width, ok
+}
+
+func skipDigits(s string, i, size int) int {
+	for end := i + size; i < end && i < len(s) && isDigit(s[i]); i++ {
+	}
+	return i
+}
+
+func isDigit(c byte) bool {
+	return '0' <= c && c <= '9'
+}
@@ -0,0 +1,111 @@
+package synchro_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/Code-Hex/synchro"
+	"github.com/Code-Hex/synchro/locale"
+	"github.com/Code-Hex/synchro/tz"
+)
+
+func TestTime_StrftimeLocale(t *testing.T) {
+	tm := synchro.New[tz.AsiaTokyo](2023, 9, 4, 14, 9, 56, 0)
+	tests := []struct {
+		name   string
+		locale *locale.Locale
+		format string
+		want   string
+	}{
+		{name: "nil is en", format: "%a %A %b %B %p", want: "Mon Monday Sep September PM"},
+		{name: "en is Strftime", locale: locale.English, format: "%c", want: tm.Strftime("%c")},
+		{name: "ja", locale: locale.Japanese, format: "%Y年%B%-d日(%a) %p%I時", want: "2023年9月4日(月) 午後02時"},
+		{name: "ja long weekday", locale: locale.Japanese, format: "%A", want: "月曜日"},
+		{name: "de", locale: locale.German, format: "%A, %d. %B %Y", want: "Montag, 04. September 2023"},
+		{name: "fr", locale: locale.French, format: "%a %d %b", want: "lun. 04 sept."},
+		{name: "es", locale: locale.Spanish, format: "%A %d de %B, %I:%M %p", want: "lunes 04 de septiembre, 02:09 p. m."},
+		{name: "upper", locale: locale.German, format: "%^a %^B", want: "MO SEPTEMBER"},
+		{name: "swap", locale: locale.French, format: "%#A", want: "LUNDI"},
+		{name: "lower period", locale: locale.Spanish, format: "%P", want: "p. m."},
+		{name: "width", locale: locale.Japanese, format: "[%5a][%-5a][%05B]", want: "[    月][月][0009月]"},
+		{name: "composition", locale: locale.German, format: "%c", want: "Mo Sep  4 14:09:56 2023"},
+		{name: "upper composition", locale: locale.German, format: "%^v", want: " 4-SEP-2023"},
+		{name: "composition r", locale: locale.Japanese, format: "%r", want: "02:09:56 午後"},
+		{name: "percent", locale: locale.Japanese, format: "%%B %B", want: "%B 9月"},
+		{name: "zone", locale: locale.Japanese, format: "%B %:z", want: "9月 +09:00"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tm.StrftimeLocale(tt.format, tt.locale); got != tt.want {
+				t.Errorf("want %q but got %q", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestStrptimeLocale(t *testing.T) {
+	want := synchro.New[tz.AsiaTokyo](2023, 9, 4, 14, 9, 56, 0)
+	tests := []struct {
+		name   string
+		locale *locale.Locale
+		source string
+		format string
+	}{
+		{name: "nil is en", source: "Monday, 04 September 2023 02:09:56 PM", format: "%A, %d %B %Y %I:%M:%S %p"},
+		{name: "ja", locale: locale.Japanese, source: "2023年9月4日(月) 午後2時9分56秒", format: "%Y年%B%e日(%a) %p%I時%M分%S秒"},
+		{name: "ja long weekday", locale: locale.Japanese, source: "月曜日 2023/9/4 14:09:56", format: "%A %Y/%m/%d %T"},
+		{name: "de", locale: locale.German, source: "Montag, 4. September 2023 14:09:56", format: "%A, %d. %B %Y %T"},
+		{name: "de short", locale: locale.German, source: "Mo 04 Sep 2023 14:09:56", format: "%a %d %b %Y %T"},
+		{name: "fr", locale: locale.French, source: "lun. 4 sept. 2023 14:09:56", format: "%a %d %b %Y %T"},
+		{name: "es", locale: locale.Spanish, source: "lunes 4 de septiembre de 2023, 2:09:56 p. m.", format: "%A %d de %B de %Y, %I:%M:%S %p"},
+		{name: "case-insensitive", locale: locale.German, source: "MONTAG 04 SEPTEMBER 2023 14:09:56", format: "%A %d %B %Y %T"},
+		{name: "full name for abbreviated", locale: locale.French, source: "lundi 04 septembre 2023 14:09:56", format: "%a %d %b %Y %T"},
+		{name: "composition", locale: locale.German, source: "Mo Sep  4 14:09:56 2023", format: "%c"},
+		{name: "zone", locale: locale.Japanese, source: "9月 4 2023 05:09:56 +00:00", format: "%B %e %Y %H:%M:%S %:z"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := synchro.StrptimeLocale[tz.AsiaTokyo](tt.source, tt.format, tt.locale)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !got.Equal(want) {
+				t.Errorf("want %v but got %v", want, got)
+			}
+		})
+	}
+
+	errTests := []struct {
+		name   string
+		locale *locale.Locale
+		source string
+		format string
+	}{
+		{name: "unknown name", locale: locale.Japanese, source: "13月", format: "%B"},
+		{name: "other locale", locale: locale.German, source: "9月", format: "%B"},
+		{name: "trailing", locale: locale.Japanese, source: "9月x", format: "%B"},
+	}
+	for _, tt := range errTests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := synchro.StrptimeLocale[tz.UTC](tt.source, tt.format, tt.locale); err == nil {
+				t.Error("want error")
+			}
+		})
+	}
+}
+
+func ExampleTime_StrftimeLocale() {
+	t := synchro.New[tz.AsiaTokyo](2023, 9, 4, 14, 9, 56, 0)
+	fmt.Println(t.StrftimeLocale("%Y年%B%-d日(%a)", locale.Japanese))
+	fmt.Println(t.StrftimeLocale("%A, %-d. %B %Y", locale.German))
+	// Output:
+	// 2023年9月4日(月)
+	// Montag, 4. September 2023
+}
+
+func ExampleStrptimeLocale() {
+	t, _ := synchro.StrptimeLocale[tz.AsiaTokyo]("2023年9月4日 月曜日", "%Y年%B%e日 %A", locale.Japanese)
+	fmt.Println(t)
+	// Output:
+	// 2023-09-04 00:00:00 +0900 JST
+}
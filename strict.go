@@ -0,0 +1,76 @@
+package synchro
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Code-Hex/synchro/iso8601"
+)
+
+// RangeError indicates that a component of a date or time is not in its
+// expected range.
+type RangeError struct {
+	Element string
+	Value   int
+	Min     int
+	Max     int
+}
+
+// Error implements the error interface.
+func (e *RangeError) Error() string {
+	return fmt.Sprintf("synchro: %d %s is not in range %d-%d", e.Value, e.Element, e.Min, e.Max)
+}
+
+// NewStrict returns the Time corresponding to
+//
+//	yyyy-mm-dd hh:mm:ss + nsec nanoseconds
+//
+// in the appropriate zone for that time in the given timezone.
+//
+// Unlike New, the month, day, hour, min, sec, and nsec values are not normalized.
+// NewStrict returns *RangeError if any of them is out of its usual range
+// (e.g., October 32), and *LocalTimeError if the wall clock time does not
+// exist in the timezone T because of a daylight saving time transition.
+// A repeated wall clock time is resolved to the earlier instant.
+func NewStrict[T TimeZone](year int, month time.Month, day int, hour int, min int, sec int, nsec int) (Time[T], error) {
+	return newStrict[T](LocalDateTime{
+		Date: iso8601.Date{Year: year, Month: month, Day: day},
+		Time: iso8601.Time{Hour: hour, Minute: min, Second: sec, Nanosecond: nsec},
+	})
+}
+
+func newStrict[T TimeZone](l LocalDateTime) (Time[T], error) {
+	if err := l.validate(); err != nil {
+		return Time[T]{}, err
+	}
+	r := LocalIn[T](l)
+	if r.kind == LocalSkipped {
+		return Time[T]{}, r.error()
+	}
+	return r.Resolve(DSTEarlier)
+}
+
+// validate checks the individual components of l against their usual ranges.
+// Unlike iso8601.Date.Validate, the year is not limited to 4 digits.
+func (l LocalDateTime) validate() error {
+	d, t := l.Date, l.Time
+	if d.Month < time.January || d.Month > time.December {
+		return &RangeError{Element: "month", Value: int(d.Month), Min: 1, Max: 12}
+	}
+	if max := daysIn(d.Year, d.Month); d.Day < 1 || d.Day > max {
+		return &RangeError{Element: "day of month", Value: d.Day, Min: 1, Max: max}
+	}
+	if t.Hour < 0 || t.Hour > 23 {
+		return &RangeError{Element: "hour", Value: t.Hour, Min: 0, Max: 23}
+	}
+	if t.Minute < 0 || t.Minute > 59 {
+		return &RangeError{Element: "minute", Value: t.Minute, Min: 0, Max: 59}
+	}
+	if t.Second < 0 || t.Second > 59 {
+		return &RangeError{Element: "second", Value: t.Second, Min: 0, Max: 59}
+	}
+	if t.Nanosecond < 0 || t.Nanosecond > 999999999 {
+		return &RangeError{Element: "nanosecond", Value: t.Nanosecond, Min: 0, Max: 999999999}
+	}
+	return nil
+}
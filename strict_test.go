@@ -0,0 +1,105 @@
+package synchro_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/Code-Hex/synchro"
+	"github.com/Code-Hex/synchro/tz"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestNewStrict(t *testing.T) {
+	tests := []struct {
+		name  string
+		month time.Month
+		day   int
+		hour  int
+		min   int
+		sec   int
+		nsec  int
+		want  *synchro.RangeError
+	}{
+		{name: "valid", month: 2, day: 28},
+		{name: "month 0", month: 0, day: 1, want: &synchro.RangeError{Element: "month", Value: 0, Min: 1, Max: 12}},
+		{name: "month 13", month: 13, day: 1, want: &synchro.RangeError{Element: "month", Value: 13, Min: 1, Max: 12}},
+		{name: "day 0", month: 1, day: 0, want: &synchro.RangeError{Element: "day of month", Value: 0, Min: 1, Max: 31}},
+		{name: "February 29", month: 2, day: 29, want: &synchro.RangeError{Element: "day of month", Value: 29, Min: 1, Max: 28}},
+		{name: "hour 24", month: 1, day: 1, hour: 24, want: &synchro.RangeError{Element: "hour", Value: 24, Min: 0, Max: 23}},
+		{name: "minute 60", month: 1, day: 1, min: 60, want: &synchro.RangeError{Element: "minute", Value: 60, Min: 0, Max: 59}},
+		{name: "second -1", month: 1, day: 1, sec: -1, want: &synchro.RangeError{Element: "second", Value: -1, Min: 0, Max: 59}},
+		{name: "nanosecond 1e9", month: 1, day: 1, nsec: 1e9, want: &synchro.RangeError{Element: "nanosecond", Value: 1e9, Min: 0, Max: 999999999}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := synchro.NewStrict[tz.UTC](2023, tt.month, tt.day, tt.hour, tt.min, tt.sec, tt.nsec)
+			if tt.want == nil {
+				if err != nil {
+					t.Fatal(err)
+				}
+				want := synchro.New[tz.UTC](2023, tt.month, tt.day, tt.hour, tt.min, tt.sec, tt.nsec)
+				if !want.Equal(got) {
+					t.Errorf("want %s but got %s", want, got)
+				}
+				return
+			}
+			var rerr *synchro.RangeError
+			if !errors.As(err, &rerr) {
+				t.Fatalf("want *RangeError but got %v", err)
+			}
+			if diff := cmp.Diff(tt.want, rerr); diff != "" {
+				t.Errorf("(-want, +got)\n%s", diff)
+			}
+		})
+	}
+
+	t.Run("skipped", func(t *testing.T) {
+		_, err := synchro.NewStrict[tz.AmericaNew_York](2023, 3, 12, 2, 30, 0, 0)
+		var lerr *synchro.LocalTimeError
+		if !errors.As(err, &lerr) {
+			t.Fatalf("want *LocalTimeError but got %v", err)
+		}
+	})
+	t.Run("repeated", func(t *testing.T) {
+		got, err := synchro.NewStrict[tz.AmericaNew_York](2023, 11, 5, 1, 30, 0, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := "2023-11-05T01:30:00-04:00"; got.Format(time.RFC3339) != want {
+			t.Errorf("want %s but got %s", want, got.Format(time.RFC3339))
+		}
+	})
+}
+
+func TestTime_ChangeStrict(t *testing.T) {
+	base := synchro.New[tz.AmericaNew_York](2023, 1, 31, 2, 30, 0, 0)
+
+	got, err := base.ChangeStrict(D(15))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := synchro.New[tz.AmericaNew_York](2023, 1, 15, 2, 30, 0, 0); !want.Equal(got) {
+		t.Errorf("want %s but got %s", want, got)
+	}
+
+	if _, err := base.ChangeStrict(D(32)); err == nil {
+		t.Error("want error for Day(32)")
+	}
+	// February 31 does not exist, while Change normalizes it to March 3.
+	if _, err := base.ChangeStrict(M(2)); err == nil {
+		t.Error("want error for February 31")
+	}
+	var lerr *synchro.LocalTimeError
+	if _, err := base.ChangeStrict(M(3), D(12)); !errors.As(err, &lerr) {
+		t.Errorf("want *LocalTimeError but got %v", err)
+	}
+}
+
+func ExampleNewStrict() {
+	_, err := synchro.NewStrict[tz.UTC](2023, time.February, 29, 0, 0, 0, 0)
+	fmt.Println(err)
+	// Output:
+	// synchro: 29 day of month is not in range 1-28
+}
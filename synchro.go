@@ -7,8 +7,6 @@ import (
 	"github.com/Code-Hex/synchro/iso8601"
 )
 
-var nowFunc = time.Now
-
 // In returns timezone-aware time.
 //
 // If the given time.Time is the zero value, a zero value Time[T] is returned.
@@ -22,14 +20,18 @@ func In[T TimeZone](tm time.Time) Time[T] {
 }
 
 // Now returns the current time with timezone.
+//
+// The current time is drawn from DefaultClock, which can be replaced by
+// SetDefaultClock. Use NowFrom to draw the current time from another Clock.
 func Now[T TimeZone]() Time[T] {
-	return In[T](nowFunc())
+	return NowFrom[T](DefaultClock())
 }
 
 type nowContextKey[T TimeZone] struct{}
 
 // NowContext returns the current time stored in the provided context.
-// If the time is not found in the context, it returns zero value.
+// If the time is not found in the context, it returns the current time of
+// the clock stored by WithClock. If neither is found, it returns zero value.
 //
 // NowContext and NowWithContext are useful when you want to store
 // the current time within the context of the executing logic.
@@ -42,10 +44,13 @@ type nowContextKey[T TimeZone] struct{}
 // in dealing with the current time within the scope of that specific request.
 func NowContext[T TimeZone](ctx context.Context) Time[T] {
 	t, ok := ctx.Value(nowContextKey[T]{}).(Time[T])
-	if !ok {
-		return Time[T]{}
+	if ok {
+		return t
 	}
-	return t
+	if c, ok := ctx.Value(clockContextKey{}).(Clock); ok {
+		return NowFrom[T](c)
+	}
+	return Time[T]{}
 }
 
 // NowWithContext returns a new context with the provided time with timezone stored in it.
@@ -70,6 +75,7 @@ func NowWithContext[T TimeZone](ctx context.Context, t Time[T]) context.Context
 // choice of time zone, and therefore the time, is not well-defined.
 // Date returns a time that is correct in one of the two zones involved
 // in the transition, but it does not guarantee which.
+// Use NewWithPolicy to resolve such times deterministically.
 //
 // This is a simple wrapper function for time.Date.
 func New[T TimeZone](year int, month time.Month, day int, hour int, min int, sec int, nsec int) Time[T] {
@@ -152,11 +158,12 @@ func UnixMicro[T TimeZone](usec int64) Time[T] {
 // until the timer fires. If efficiency is a concern, use NewTimer
 // instead and call Timer.Stop if the timer is no longer needed.
 //
+// It waits on DefaultClock, which can be replaced by SetDefaultClock. Use
+// AfterFrom to wait on another Clock.
+//
 // This is a simple wrapper function for time.After.
 func After[T TimeZone](d time.Duration) <-chan Time[T] {
-	c := make(chan Time[T], 1)
-	go func() { c <- In[T](<-time.After(d)) }()
-	return c
+	return AfterFrom[T](DefaultClock(), d)
 }
 
 // ConvertTz can be used to convert a time from one time zone to another.
@@ -1,9 +1,9 @@
 package synchro
 
 import (
-	"math"
 	"time"
 
+	"github.com/Code-Hex/synchro/iso8601"
 	"github.com/itchyny/timefmt-go"
 )
 
@@ -40,18 +40,6 @@ func (t Time[T]) EndOfMonth() Time[T] {
 	return startOfMonth.AddDate(0, 1, 0).Add(-1 * time.Nanosecond)
 }
 
-// StartOfWeek returns Time for start of the week.
-func (t Time[T]) StartOfWeek() Time[T] {
-	dayOfWeek := t.Weekday()
-	return t.Add(-time.Duration(dayOfWeek) * 24 * time.Hour)
-}
-
-// EndOfWeek returns Time for end of the week.
-func (t Time[T]) EndOfWeek() Time[T] {
-	dayOfWeek := t.Weekday()
-	return t.Add(time.Duration(time.Saturday-dayOfWeek+1) * 24 * time.Hour).Add(-1 * time.Nanosecond)
-}
-
 // StartOfQuarter returns a Time for start of the quarter.
 func (t Time[T]) StartOfQuarter() Time[T] {
 	return t.Quarter().Start()
@@ -84,14 +72,138 @@ func (t Time[T]) IsBetween(from Time[T], to Time[T]) bool {
 	return p.Contains(t) == 1
 }
 
+// AddISODuration returns the time t+d. Years, months, weeks and days are added
+// by the calendar in T, and then the clock components are added. The day is
+// clamped to the last day of the month as Date.AddMonths.
+//
+// This is a wrapper method for (iso8601.Duration).AddTo.
+func (t Time[T]) AddISODuration(d iso8601.Duration) Time[T] {
+	return In[T](d.AddTo(t.StdTime()))
+}
+
 // DiffInCalendarDays calculates the difference in calendar days between t and u. (t-u)
-// Calendar days are calculated by considering only the dates, excluding the times,
+// Calendar days are calculated by considering only the dates in T, excluding the times,
 // and then determining the difference in days.
 func (t Time[T]) DiffInCalendarDays(u Time[T]) int {
 	const day = 24 * time.Hour
-	t1 := t.Truncate(day)
-	u1 := u.Truncate(day)
-	return int(math.Ceil(float64(t1.Sub(u1)) / float64(day)))
+	return int(wallDate(t).Sub(wallDate(u)) / day)
+}
+
+// DiffInWeeks calculates the difference in whole weeks between t and u. (t-u)
+// The weeks are calculated on the local dates and times in T, so that a week
+// is always 7 days even if the clocks are changed during the week. The result
+// is truncated toward zero.
+func (t Time[T]) DiffInWeeks(u Time[T]) int {
+	const week = 7 * 24 * time.Hour
+	return int(wallTime(t).Sub(wallTime(u)) / week)
+}
+
+// DiffInMonths calculates the difference in whole months between t and u. (t-u)
+// The months are calculated on the local dates and times in T, and the result is
+// truncated toward zero.
+//
+// The months are counted from u toward t as DiffComponents. A month after a day
+// is the same day of the next month. If the next month does not have the day,
+// it is the last day of the month instead. For example, one month after
+// January 31 is February 28 (or 29 in a leap year), so February 28 minus
+// January 31 is 1, and February 27 minus January 31 is 0. In the same way,
+// January 31 minus February 28 is 0, because one month before February 28 is
+// January 28.
+func (t Time[T]) DiffInMonths(u Time[T]) int {
+	from, to := wallTime(u), wallTime(t)
+	months := (to.Year()-from.Year())*12 + int(to.Month()-from.Month())
+	switch {
+	case months > 0 && addMonthsNoOverflow(from, months).After(to):
+		months--
+	case months < 0 && addMonthsNoOverflow(from, months).Before(to):
+		months++
+	}
+	return months
+}
+
+// DiffInYears calculates the difference in whole years between t and u. (t-u)
+// The years are calculated by DiffInMonths, so that one year after February 29
+// is February 28 in a common year.
+func (t Time[T]) DiffInYears(u Time[T]) int {
+	return t.DiffInMonths(u) / 12
+}
+
+// DiffComponents returns the difference between t and u (t-u) as the calendar
+// components: years, months, days and the clock. Adding the result to u by
+// AddISODuration reproduces t exactly, even across the daylight saving time
+// changes in T.
+//
+// The components are the largest ones from u toward t: as many months as
+// possible are taken first, then days, and the rest is the clock. The months
+// are added as AddISODuration, which clamps the day to the last day of the
+// month. For example, the difference between January 31 and February 28 is
+// P1M, and the difference between January 31 and February 27 is P27D.
+func (t Time[T]) DiffComponents(u Time[T]) iso8601.Duration {
+	var d iso8601.Duration
+	dir := 1
+	if t.Before(u) {
+		dir, d.Negative = -1, true
+	}
+	// past reports whether x is beyond t seen from u.
+	past := func(x Time[T]) bool {
+		return x.Compare(t) == dir
+	}
+	// at returns the time after the months and days from u as AddISODuration.
+	at := func(months, days int) Time[T] {
+		return u.AddISODuration(iso8601.Duration{Month: time.Month(dir * months), Day: dir * days})
+	}
+	from, to := wallTime(u), wallTime(t)
+	months := dir * ((to.Year()-from.Year())*12 + int(to.Month()-from.Month()))
+	for months > 0 && past(at(months, 0)) {
+		months--
+	}
+	days := max(dir*int(wallDate(t).Sub(wallDate(at(months, 0)))/(24*time.Hour)), 0)
+	// The months and days are added at once as AddISODuration does. It
+	// differs from adding them one by one if the time is skipped by
+	// the daylight saving time after adding the months.
+	for days > 0 && past(at(months, days)) {
+		days--
+	}
+	pos := at(months, days)
+
+	clock := t.Sub(pos)
+	if clock < 0 {
+		clock = -clock
+	}
+	d.Year, d.Month, d.Day = months/12, time.Month(months%12), days
+	d.Hour = int(clock / time.Hour)
+	clock -= time.Duration(d.Hour) * time.Hour
+	d.Minute = int(clock / time.Minute)
+	clock -= time.Duration(d.Minute) * time.Minute
+	d.Second = int(clock / time.Second)
+	clock -= time.Duration(d.Second) * time.Second
+	d.Millisecond = int(clock / time.Millisecond)
+	clock -= time.Duration(d.Millisecond) * time.Millisecond
+	d.Microsecond = int(clock / time.Microsecond)
+	clock -= time.Duration(d.Microsecond) * time.Microsecond
+	d.Nanosecond = int(clock)
+	return d
+}
+
+// wallTime returns the local date and time of t in T as UTC, so that the
+// difference of them is not affected by the daylight saving time.
+func wallTime[T TimeZone](t Time[T]) time.Time {
+	return t.LocalDateTime().UTC()
+}
+
+// wallDate returns the local date of t in T as UTC.
+func wallDate[T TimeZone](t Time[T]) time.Time {
+	year, month, day := t.Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+// addMonthsNoOverflow adds months to t. If the month does not have the day of
+// t, the day is the last day of the month.
+func addMonthsNoOverflow(t time.Time, months int) time.Time {
+	year, month, day := t.Date()
+	hour, minute, sec := t.Clock()
+	first := time.Date(year, month+time.Month(months), 1, hour, minute, sec, t.Nanosecond(), t.Location())
+	return first.AddDate(0, 0, min(day, daysIn(first.Year(), first.Month()))-1)
 }
 
 // Strftime formats the time according to the given format string.
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/Code-Hex/synchro"
+	"github.com/Code-Hex/synchro/iso8601"
 	"github.com/Code-Hex/synchro/tz"
 )
 
@@ -204,6 +205,11 @@ func TestTime_StartOfWeek(t *testing.T) {
 			time: synchro.New[tz.UTC](2023, 9, 10, 0, 0, 0, 0),
 			want: synchro.New[tz.UTC](2023, 9, 10, 0, 0, 0, 0),
 		},
+		{
+			// The time of day is kept.
+			time: synchro.New[tz.UTC](2023, 9, 6, 15, 30, 45, 123),
+			want: synchro.New[tz.UTC](2023, 9, 3, 15, 30, 45, 123),
+		},
 	}
 	for _, tc := range cases {
 		d := tc.time.StartOfWeek()
@@ -254,6 +260,11 @@ func TestTime_EndOfWeek(t *testing.T) {
 			time: synchro.New[tz.UTC](2023, 9, 10, 0, 0, 0, 0),
 			want: synchro.New[tz.UTC](2023, 9, 16, 23, 59, 59, 999999999),
 		},
+		{
+			// The time of day is kept.
+			time: synchro.New[tz.UTC](2023, 9, 6, 15, 30, 45, 123),
+			want: synchro.New[tz.UTC](2023, 9, 10, 15, 30, 45, 122),
+		},
 	}
 	for _, tc := range cases {
 		d := tc.time.EndOfWeek()
@@ -575,6 +586,10 @@ func TestTime_IsBetween(t *testing.T) {
 		if got != tc.want {
 			t.Errorf("for %q, want %v but got %v", tc.time, tc.want, got)
 		}
+		// The reversed range contains nothing.
+		if tc.time.IsBetween(to, from) {
+			t.Errorf("for %q, want false with the reversed arguments", tc.time)
+		}
 	}
 }
 
@@ -615,6 +630,191 @@ func TestDiffInCalendarDays(t *testing.T) {
 	}
 }
 
+func TestTime_AddISODuration(t *testing.T) {
+	// The clocks are set forward by one hour on 2023-03-12 in New York.
+	tm := synchro.New[tz.AmericaNew_York](2023, 3, 11, 12, 0, 0, 0)
+	tests := []struct {
+		d    iso8601.Duration
+		want synchro.Time[tz.AmericaNew_York]
+	}{
+		{d: iso8601.Duration{Day: 1}, want: synchro.New[tz.AmericaNew_York](2023, 3, 12, 12, 0, 0, 0)},
+		{d: iso8601.Duration{Hour: 24}, want: synchro.New[tz.AmericaNew_York](2023, 3, 12, 13, 0, 0, 0)},
+		{d: iso8601.Duration{Month: 1, Minute: 30}, want: synchro.New[tz.AmericaNew_York](2023, 4, 11, 12, 30, 0, 0)},
+		{d: iso8601.Duration{Year: 1, Negative: true}, want: synchro.New[tz.AmericaNew_York](2022, 3, 11, 12, 0, 0, 0)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.d.String(), func(t *testing.T) {
+			if got := tm.AddISODuration(tt.d); !got.Equal(tt.want) {
+				t.Errorf("want %s but got %s", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestDiffInCalendarDays_Zone(t *testing.T) {
+	// The dates differ in Asia/Tokyo, but not in UTC.
+	tm := synchro.New[tz.AsiaTokyo](2023, 1, 2, 8, 0, 0, 0)
+	u := synchro.New[tz.AsiaTokyo](2023, 1, 1, 10, 0, 0, 0)
+	if got := tm.DiffInCalendarDays(u); got != 1 {
+		t.Errorf("want 1 but got %d", got)
+	}
+	if got := u.DiffInCalendarDays(tm); got != -1 {
+		t.Errorf("want -1 but got %d", got)
+	}
+}
+
+func TestTime_DiffInMonths(t *testing.T) {
+	date := func(year int, month time.Month, day int) synchro.Time[tz.AsiaTokyo] {
+		return synchro.New[tz.AsiaTokyo](year, month, day, 0, 0, 0, 0)
+	}
+	cases := []struct {
+		t, u   synchro.Time[tz.AsiaTokyo]
+		months int
+		years  int
+	}{
+		{t: date(2023, 2, 28), u: date(2023, 1, 31), months: 1},
+		{t: date(2023, 2, 27), u: date(2023, 1, 31), months: 0},
+		{t: date(2024, 2, 29), u: date(2024, 1, 31), months: 1},
+		{t: date(2023, 3, 31), u: date(2023, 2, 28), months: 1},
+		{t: date(2023, 3, 31), u: date(2023, 1, 31), months: 2},
+		{t: date(2025, 2, 28), u: date(2024, 2, 29), months: 12, years: 1},
+		{t: date(2025, 2, 27), u: date(2024, 2, 29), months: 11, years: 0},
+		{t: date(2023, 1, 31), u: date(2023, 2, 28), months: 0},
+		{t: date(2023, 1, 28), u: date(2023, 2, 28), months: -1},
+		{t: date(2023, 2, 28), u: date(2023, 3, 31), months: -1},
+		{t: date(2024, 2, 29), u: date(2025, 2, 28), months: -11, years: 0},
+		{t: date(2024, 2, 28), u: date(2025, 2, 28), months: -12, years: -1},
+		{t: date(2020, 5, 15), u: date(2023, 5, 14), months: -35, years: -2},
+		{t: date(2020, 5, 15), u: date(2023, 5, 16), months: -36, years: -3},
+		{t: date(2023, 5, 15).Add(-time.Second), u: date(2023, 4, 15), months: 0},
+	}
+	for _, tc := range cases {
+		t.Run(fmt.Sprintf("%s-%s", tc.t.Format(time.DateOnly), tc.u.Format(time.DateOnly)), func(t *testing.T) {
+			if got := tc.t.DiffInMonths(tc.u); got != tc.months {
+				t.Errorf("want %d months but got %d", tc.months, got)
+			}
+			if got := tc.t.DiffInYears(tc.u); got != tc.years {
+				t.Errorf("want %d years but got %d", tc.years, got)
+			}
+			if got := componentMonths(tc.t.DiffComponents(tc.u)); got != tc.months {
+				t.Errorf("want %d months in DiffComponents but got %d", tc.months, got)
+			}
+		})
+	}
+
+	t.Run("agrees with DiffComponents", func(t *testing.T) {
+		base := date(2023, 1, 28)
+		for i := range 100 {
+			u := base.AddDate(0, 0, i)
+			for j := range 100 {
+				tm := u.AddDate(0, 0, j*11-550)
+				if got, want := tm.DiffInMonths(u), componentMonths(tm.DiffComponents(u)); got != want {
+					t.Fatalf("%s - %s: want %d months but got %d", tm, u, want, got)
+				}
+			}
+		}
+	})
+}
+
+// componentMonths returns the whole months in d.
+func componentMonths(d iso8601.Duration) int {
+	months := d.Year*12 + int(d.Month)
+	if d.Negative {
+		return -months
+	}
+	return months
+}
+
+func TestTime_DiffInWeeks(t *testing.T) {
+	// The clocks are set forward by one hour on 2023-03-12 in New York.
+	u := synchro.New[tz.AmericaNew_York](2023, 3, 6, 12, 0, 0, 0)
+	cases := []struct {
+		t    synchro.Time[tz.AmericaNew_York]
+		want int
+	}{
+		{t: synchro.New[tz.AmericaNew_York](2023, 3, 13, 12, 0, 0, 0), want: 1},
+		{t: synchro.New[tz.AmericaNew_York](2023, 3, 13, 11, 59, 0, 0), want: 0},
+		{t: synchro.New[tz.AmericaNew_York](2023, 3, 27, 12, 0, 0, 0), want: 3},
+		{t: synchro.New[tz.AmericaNew_York](2023, 2, 27, 12, 0, 0, 0), want: -1},
+		{t: synchro.New[tz.AmericaNew_York](2023, 2, 27, 12, 0, 1, 0), want: 0},
+	}
+	for _, tc := range cases {
+		if got := tc.t.DiffInWeeks(u); got != tc.want {
+			t.Errorf("%s: want %d but got %d", tc.t, tc.want, got)
+		}
+	}
+}
+
+func TestTime_DiffComponents(t *testing.T) {
+	type NY = tz.AmericaNew_York
+	cases := []struct {
+		t, u synchro.Time[NY]
+		want iso8601.Duration
+	}{
+		{
+			t:    synchro.New[NY](2024, 3, 15, 13, 30, 0, 5),
+			u:    synchro.New[NY](2023, 1, 10, 12, 0, 0, 0),
+			want: iso8601.Duration{Year: 1, Month: 2, Day: 5, Hour: 1, Minute: 30, Nanosecond: 5},
+		},
+		{
+			t:    synchro.New[NY](2023, 2, 28, 0, 0, 0, 0),
+			u:    synchro.New[NY](2023, 1, 31, 0, 0, 0, 0),
+			want: iso8601.Duration{Month: 1},
+		},
+		{
+			t:    synchro.New[NY](2023, 2, 27, 0, 0, 0, 0),
+			u:    synchro.New[NY](2023, 1, 31, 0, 0, 0, 0),
+			want: iso8601.Duration{Day: 27},
+		},
+		{
+			// The day of 2023-03-12 is 23 hours long.
+			t:    synchro.New[NY](2023, 3, 12, 12, 0, 0, 0),
+			u:    synchro.New[NY](2023, 3, 11, 12, 0, 0, 0),
+			want: iso8601.Duration{Day: 1},
+		},
+		{
+			t:    synchro.New[NY](2023, 3, 12, 11, 0, 0, 0),
+			u:    synchro.New[NY](2023, 3, 11, 12, 0, 0, 0),
+			want: iso8601.Duration{Hour: 22},
+		},
+		{
+			t:    synchro.New[NY](2023, 1, 10, 12, 0, 0, 0),
+			u:    synchro.New[NY](2023, 3, 15, 13, 0, 0, 0),
+			want: iso8601.Duration{Month: 2, Day: 5, Hour: 1, Negative: true},
+		},
+		{
+			t:    synchro.New[NY](2023, 1, 10, 12, 0, 0, 0),
+			u:    synchro.New[NY](2023, 1, 10, 12, 0, 0, 0),
+			want: iso8601.Duration{},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(fmt.Sprintf("%s-%s", tc.t, tc.u), func(t *testing.T) {
+			got := tc.t.DiffComponents(tc.u)
+			if got != tc.want {
+				t.Errorf("want %s but got %s", tc.want, got)
+			}
+			if back := tc.u.AddISODuration(got); !back.Equal(tc.t) {
+				t.Errorf("want %s but got %s after adding back", tc.t, back)
+			}
+		})
+	}
+
+	t.Run("round trip", func(t *testing.T) {
+		base := synchro.New[NY](2023, 1, 31, 23, 30, 0, 0)
+		for i := range 400 {
+			u := base.Add(time.Duration(i) * 37 * time.Hour)
+			for j := range 50 {
+				tm := u.AddDate(0, 0, j*13-300).Add(time.Duration(j) * 71 * time.Minute)
+				d := tm.DiffComponents(u)
+				if back := u.AddISODuration(d); !back.Equal(tm) {
+					t.Fatalf("%s - %s = %s, but adding back is %s", tm, u, d, back)
+				}
+			}
+		}
+	})
+}
+
 func ExampleTime_Strftime() {
 	t := synchro.New[tz.AsiaTokyo](2023, 9, 2, 14, 9, 56, 0)
 	fmt.Println(t.Strftime("%Y-%m-%d %H:%M:%S"))
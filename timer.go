@@ -0,0 +1,204 @@
+package synchro
+
+import (
+	"sync"
+	"time"
+)
+
+// Timer is a timezone-aware wrapper of time.Timer. When the Timer expires,
+// the current time with timezone will be sent on C, unless the Timer was
+// created by AfterFunc.
+//
+// As time.Timer since Go 1.23, no stale value is received from C after
+// Stop or Reset returns. No goroutine is running while the Timer is waiting.
+type Timer[T TimeZone] struct {
+	C <-chan Time[T]
+
+	c     chan Time[T]
+	f     func(Time[T])
+	clock TimerClock
+	mu    sync.Mutex
+	// stopFunc stops the pending call of the clock.
+	stopFunc func() bool
+	// gen invalidates the pending sends of the stopped calls.
+	gen uint64
+	// active reports whether the timer is waiting to send the time.
+	active bool
+}
+
+// NewTimer creates a new Timer that will send the current time with timezone
+// on its channel after at least duration d.
+func NewTimer[T TimeZone](d time.Duration) *Timer[T] {
+	return NewTimerFrom[T](SystemClock{}, d)
+}
+
+// NewTimerFrom is like NewTimer, but the duration elapses on the clock, and
+// the time is the current time of the clock.
+func NewTimerFrom[T TimeZone](clock TimerClock, d time.Duration) *Timer[T] {
+	c := make(chan Time[T], 1)
+	t := &Timer[T]{C: c, c: c, clock: clock, active: true}
+	t.stopFunc = clock.AfterFunc(d, t.sendFunc(0))
+	return t
+}
+
+// AfterFunc waits for the duration to elapse and then calls f with
+// the current time with timezone in its own goroutine. It returns a Timer
+// that can be used to cancel the call using its Stop method.
+// The returned Timer's C field is not used and will be nil.
+func AfterFunc[T TimeZone](d time.Duration, f func(Time[T])) *Timer[T] {
+	t := &Timer[T]{f: f, clock: SystemClock{}}
+	t.stopFunc = t.clock.AfterFunc(d, t.callFunc)
+	return t
+}
+
+func (t *Timer[T]) callFunc() {
+	t.f(In[T](t.clock.Now()))
+}
+
+func (t *Timer[T]) sendFunc(gen uint64) func() {
+	return func() {
+		now := In[T](t.clock.Now())
+		t.mu.Lock()
+		defer t.mu.Unlock()
+		if gen != t.gen {
+			return
+		}
+		t.active = false
+		select {
+		case t.c <- now:
+		default:
+		}
+	}
+}
+
+// Stop prevents the Timer from firing. It returns true if the call stops
+// the timer, false if the timer has already expired or been stopped.
+//
+// For a Timer created with AfterFunc, if Stop returns false, then the timer
+// has already expired and the function f has been started in its own goroutine.
+func (t *Timer[T]) Stop() bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.f != nil {
+		return t.stopFunc()
+	}
+	return t.stop()
+}
+
+func (t *Timer[T]) stop() bool {
+	// The send which is already started but waiting for the lock is also
+	// stopped by incrementing gen.
+	t.gen++
+	t.stopFunc()
+	stopped := t.active
+	t.active = false
+	select {
+	case <-t.c:
+		// The time has not been received yet.
+		stopped = true
+	default:
+	}
+	return stopped
+}
+
+// Reset changes the timer to expire after duration d. It returns true if
+// the timer had been active, false if the timer had expired or been stopped.
+func (t *Timer[T]) Reset(d time.Duration) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.f != nil {
+		active := t.stopFunc()
+		t.stopFunc = t.clock.AfterFunc(d, t.callFunc)
+		return active
+	}
+	active := t.stop()
+	t.active = true
+	t.stopFunc = t.clock.AfterFunc(d, t.sendFunc(t.gen))
+	return active
+}
+
+// Ticker is a timezone-aware wrapper of time.Ticker. It delivers the current
+// time with timezone on C at intervals. If the receiver is slow, ticks are
+// dropped as time.Ticker does.
+//
+// As time.Ticker since Go 1.23, no stale value is received from C after
+// Stop or Reset returns. No goroutine is running while the Ticker is waiting.
+type Ticker[T TimeZone] struct {
+	C <-chan Time[T]
+
+	c    chan Time[T]
+	mu   sync.Mutex
+	t    *time.Timer
+	d    time.Duration
+	next time.Time
+	gen  uint64
+}
+
+// NewTicker returns a new Ticker containing a channel that will send
+// the current time with timezone on the channel after each tick.
+// The duration d must be greater than zero; if not, NewTicker will panic.
+func NewTicker[T TimeZone](d time.Duration) *Ticker[T] {
+	if d <= 0 {
+		panic("synchro: non-positive interval for NewTicker")
+	}
+	c := make(chan Time[T], 1)
+	t := &Ticker[T]{C: c, c: c}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.start(d)
+	return t
+}
+
+func (t *Ticker[T]) start(d time.Duration) {
+	t.d = d
+	t.next = time.Now().Add(d)
+	gen := t.gen
+	t.t = time.AfterFunc(d, func() { t.tick(gen) })
+}
+
+func (t *Ticker[T]) tick(gen uint64) {
+	now := time.Now()
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if gen != t.gen {
+		return
+	}
+	select {
+	case t.c <- In[T](now):
+	default:
+	}
+	// Skip the missed ticks to keep the phase.
+	if !t.next.After(now) {
+		t.next = t.next.Add((now.Sub(t.next)/t.d + 1) * t.d)
+	}
+	t.t.Reset(t.next.Sub(now))
+}
+
+// Stop turns off a ticker. After Stop, no more ticks will be sent.
+func (t *Ticker[T]) Stop() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.stop()
+}
+
+func (t *Ticker[T]) stop() {
+	t.gen++
+	t.t.Stop()
+	select {
+	case <-t.c:
+	default:
+	}
+}
+
+// Reset stops a ticker and resets its period to the specified duration.
+// The next tick will arrive after the new period elapses.
+// The duration d must be greater than zero; if not, Reset will panic.
+func (t *Ticker[T]) Reset(d time.Duration) {
+	if d <= 0 {
+		panic("synchro: non-positive interval for Ticker.Reset")
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.stop()
+	t.start(d)
+}
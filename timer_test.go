@@ -0,0 +1,261 @@
+package synchro_test
+
+import (
+	"fmt"
+	"runtime"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Code-Hex/synchro"
+	"github.com/Code-Hex/synchro/tz"
+)
+
+func TestTimer(t *testing.T) {
+	t.Run("fires", func(t *testing.T) {
+		timer := synchro.NewTimer[tz.AsiaTokyo](time.Millisecond)
+		select {
+		case got := <-timer.C:
+			if loc := got.Location().String(); loc != "Asia/Tokyo" {
+				t.Errorf("want Asia/Tokyo but got %s", loc)
+			}
+		case <-time.After(time.Second):
+			t.Fatal("timeout")
+		}
+		if timer.Stop() {
+			t.Error("want false for Stop after the timer fired")
+		}
+	})
+	t.Run("stop", func(t *testing.T) {
+		timer := synchro.NewTimer[tz.UTC](time.Hour)
+		if !timer.Stop() {
+			t.Error("want true for Stop of the active timer")
+		}
+		if timer.Stop() {
+			t.Error("want false for Stop of the stopped timer")
+		}
+	})
+	t.Run("no stale value after stop", func(t *testing.T) {
+		timer := synchro.NewTimer[tz.UTC](time.Millisecond)
+		time.Sleep(10 * time.Millisecond)
+		// The time has been sent but not received.
+		if !timer.Stop() {
+			t.Error("want true for Stop before receiving the value")
+		}
+		select {
+		case got := <-timer.C:
+			t.Fatalf("want no value but got %s", got)
+		case <-time.After(10 * time.Millisecond):
+		}
+	})
+	t.Run("reset", func(t *testing.T) {
+		timer := synchro.NewTimer[tz.UTC](time.Hour)
+		if !timer.Reset(time.Millisecond) {
+			t.Error("want true for Reset of the active timer")
+		}
+		select {
+		case <-timer.C:
+		case <-time.After(time.Second):
+			t.Fatal("timeout")
+		}
+		if timer.Reset(time.Hour) {
+			t.Error("want false for Reset of the expired timer")
+		}
+		timer.Stop()
+	})
+}
+
+func TestAfterFunc(t *testing.T) {
+	done := make(chan synchro.Time[tz.AsiaTokyo], 1)
+	timer := synchro.AfterFunc(time.Millisecond, func(t synchro.Time[tz.AsiaTokyo]) {
+		done <- t
+	})
+	if timer.C != nil {
+		t.Error("want nil channel")
+	}
+	select {
+	case got := <-done:
+		if loc := got.Location().String(); loc != "Asia/Tokyo" {
+			t.Errorf("want Asia/Tokyo but got %s", loc)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("timeout")
+	}
+
+	stopped := synchro.AfterFunc(time.Hour, func(synchro.Time[tz.UTC]) {
+		t.Error("want not called")
+	})
+	if !stopped.Stop() {
+		t.Error("want true for Stop of the active timer")
+	}
+}
+
+func TestTicker(t *testing.T) {
+	ticker := synchro.NewTicker[tz.AsiaTokyo](5 * time.Millisecond)
+	defer ticker.Stop()
+	var prev synchro.Time[tz.AsiaTokyo]
+	for i := 0; i < 3; i++ {
+		select {
+		case got := <-ticker.C:
+			if loc := got.Location().String(); loc != "Asia/Tokyo" {
+				t.Errorf("want Asia/Tokyo but got %s", loc)
+			}
+			if !got.After(prev) {
+				t.Errorf("want %s after %s", got, prev)
+			}
+			prev = got
+		case <-time.After(time.Second):
+			t.Fatal("timeout")
+		}
+	}
+
+	ticker.Reset(time.Hour)
+	select {
+	case got := <-ticker.C:
+		t.Fatalf("want no value after Reset but got %s", got)
+	case <-time.After(20 * time.Millisecond):
+	}
+
+	ticker.Reset(time.Millisecond)
+	ticker.Stop()
+	select {
+	case got := <-ticker.C:
+		t.Fatalf("want no value after Stop but got %s", got)
+	case <-time.After(20 * time.Millisecond):
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("want panic for non-positive interval")
+		}
+	}()
+	synchro.NewTicker[tz.UTC](0)
+}
+
+// manualClock is a TimerClock whose calls are fired by the test. Now waits
+// for the time given by the test, so that the test can run while a call has
+// been started but has not got the current time yet.
+type manualClock struct {
+	now   chan time.Time
+	mu    sync.Mutex
+	calls []*manualCall
+}
+
+type manualCall struct {
+	f    func()
+	done bool
+}
+
+var _ synchro.TimerClock = (*manualClock)(nil)
+
+func newManualClock() *manualClock {
+	return &manualClock{now: make(chan time.Time)}
+}
+
+func (c *manualClock) Now() time.Time { return <-c.now }
+
+func (c *manualClock) After(time.Duration) <-chan time.Time {
+	panic("manualClock: After is not supported")
+}
+
+func (c *manualClock) AfterFunc(_ time.Duration, f func()) func() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	call := &manualCall{f: f}
+	c.calls = append(c.calls, call)
+	return func() bool {
+		c.mu.Lock()
+		defer c.mu.Unlock()
+		stopped := !call.done
+		call.done = true
+		return stopped
+	}
+}
+
+// fire starts the last call in its own goroutine. The returned function gives
+// the current time to the call and waits for it to return.
+func (c *manualClock) fire() func(now time.Time) {
+	c.mu.Lock()
+	call := c.calls[len(c.calls)-1]
+	call.done = true
+	c.mu.Unlock()
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		call.f()
+	}()
+	return func(now time.Time) {
+		c.now <- now
+		<-done
+	}
+}
+
+func TestTimer_StopPendingSend(t *testing.T) {
+	now := time.Date(2023, 9, 2, 14, 0, 0, 0, time.UTC)
+	t.Run("stop", func(t *testing.T) {
+		c := newManualClock()
+		timer := synchro.NewTimerFrom[tz.UTC](c, time.Hour)
+		finish := c.fire()
+		if !timer.Stop() {
+			t.Error("want true for Stop while the send is pending")
+		}
+		finish(now)
+		select {
+		case got := <-timer.C:
+			t.Fatalf("want no value but got %s", got)
+		default:
+		}
+		if timer.Stop() {
+			t.Error("want false for Stop of the stopped timer")
+		}
+	})
+	t.Run("reset", func(t *testing.T) {
+		c := newManualClock()
+		timer := synchro.NewTimerFrom[tz.UTC](c, time.Hour)
+		finish := c.fire()
+		if !timer.Reset(time.Hour) {
+			t.Error("want true for Reset while the send is pending")
+		}
+		finish(now)
+		select {
+		case got := <-timer.C:
+			t.Fatalf("want no value but got %s", got)
+		default:
+		}
+		c.fire()(now.Add(time.Hour))
+		select {
+		case got := <-timer.C:
+			if want := synchro.In[tz.UTC](now.Add(time.Hour)); !want.Equal(got) {
+				t.Errorf("want %s but got %s", want, got)
+			}
+		default:
+			t.Fatal("want the value after Reset")
+		}
+		if timer.Stop() {
+			t.Error("want false for Stop after receiving the value")
+		}
+	})
+}
+
+func TestTimer_NoGoroutine(t *testing.T) {
+	before := runtime.NumGoroutine()
+	timers := make([]*synchro.Timer[tz.UTC], 100)
+	for i := range timers {
+		timers[i] = synchro.NewTimer[tz.UTC](time.Hour)
+	}
+	_ = synchro.AfterFrom[tz.UTC](synchro.SystemClock{}, time.Hour)
+	if after := runtime.NumGoroutine(); after > before {
+		t.Errorf("want no new goroutines but got %d -> %d", before, after)
+	}
+	for _, timer := range timers {
+		timer.Stop()
+	}
+}
+
+func ExampleNewTimer() {
+	timer := synchro.NewTimer[tz.AsiaTokyo](time.Millisecond)
+	t := <-timer.C
+	fmt.Println(t.Location())
+	// Output:
+	// Asia/Tokyo
+}
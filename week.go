@@ -0,0 +1,117 @@
+package synchro
+
+import "time"
+
+// WeekRule defines the first day of the week and how the first week of
+// a year or a month is determined.
+type WeekRule struct {
+	// FirstDay is the first day of the week.
+	FirstDay time.Weekday
+
+	// MinDays is the minimal number of days in the first week of a year or
+	// a month. It is clamped to the range 1-7.
+	MinDays int
+}
+
+var (
+	// WeekRuleISO is the rule of ISO 8601. Weeks start on Monday and the first
+	// week of the year contains at least 4 days, that is, it contains January 4.
+	WeekRuleISO = WeekRule{FirstDay: time.Monday, MinDays: 4}
+
+	// WeekRuleUS is the rule used in the United States. Weeks start on Sunday
+	// and the first week of the year contains January 1.
+	WeekRuleUS = WeekRule{FirstDay: time.Sunday, MinDays: 1}
+
+	// WeekRuleMiddleEast is the rule used in many Middle Eastern countries.
+	// Weeks start on Saturday and the first week of the year contains January 1.
+	WeekRuleMiddleEast = WeekRule{FirstDay: time.Saturday, MinDays: 1}
+)
+
+// weekRuleOf returns the first rule, or WeekRuleUS if rules is empty.
+func weekRuleOf(rules []WeekRule) WeekRule {
+	if len(rules) == 0 {
+		return WeekRuleUS
+	}
+	return rules[0]
+}
+
+func (r WeekRule) minDays() int {
+	return min(max(r.MinDays, 1), 7)
+}
+
+// offset returns the number of days from the first day of the week to wd.
+func (r WeekRule) offset(wd time.Weekday) int {
+	return (int(wd) - int(r.FirstDay) + 7) % 7
+}
+
+// week returns the week number of the nth (1-based) day of a year or a month
+// whose first day is first. It returns 0 for the days before the first week.
+func (r WeekRule) week(n int, first time.Weekday) int {
+	o := r.offset(first)
+	week := (n - 1 + o) / 7
+	if 7-o >= r.minDays() {
+		week++
+	}
+	return week
+}
+
+// StartOfWeek returns Time for start of the week, which is the first day of
+// the week at the same time of day as t.
+//
+// The week follows the given WeekRule. If it is omitted, weeks start on Sunday
+// as WeekRuleUS.
+func (t Time[T]) StartOfWeek(rule ...WeekRule) Time[T] {
+	r := weekRuleOf(rule)
+	return t.AddDate(0, 0, -r.offset(t.Weekday()))
+}
+
+// EndOfWeek returns Time for end of the week, which is one nanosecond before
+// the start of the next week as StartOfWeek.
+//
+// The week follows the given WeekRule. If it is omitted, weeks start on Sunday
+// as WeekRuleUS.
+func (t Time[T]) EndOfWeek(rule ...WeekRule) Time[T] {
+	return t.StartOfWeek(rule...).AddDate(0, 0, 7).Add(-1 * time.Nanosecond)
+}
+
+// WeekOfYear returns the week-numbering year and the week number in which t
+// occurs. Week numbers range from 1 to 53.
+//
+// The week follows the given WeekRule. If it is omitted, WeekRuleUS is used.
+// Days at the beginning of a year may belong to the last week of the previous year,
+// and days at the end of a year may belong to the first week of the next year.
+// For example, WeekRuleISO gives the same result as ISOWeek.
+func (t Time[T]) WeekOfYear(rule ...WeekRule) (year, week int) {
+	r := weekRuleOf(rule)
+	year = t.Year()
+	yday := t.YearDay()
+	jan1 := time.Date(year, 1, 1, 0, 0, 0, 0, time.UTC).Weekday()
+	week = r.week(yday, jan1)
+	if week == 0 {
+		// The last week of the previous year.
+		dec31 := time.Date(year-1, 12, 31, 0, 0, 0, 0, time.UTC)
+		return year - 1, r.week(dec31.YearDay(), time.Date(year-1, 1, 1, 0, 0, 0, 0, time.UTC).Weekday())
+	}
+	nextJan1 := time.Date(year+1, 1, 1, 0, 0, 0, 0, time.UTC).Weekday()
+	if o := r.offset(nextJan1); 7-o >= r.minDays() {
+		daysInYear := time.Date(year, 12, 31, 0, 0, 0, 0, time.UTC).YearDay()
+		if daysInYear-yday+1 <= o {
+			// The same week as January 1 of the next year.
+			return year + 1, 1
+		}
+	}
+	return year, week
+}
+
+// WeekOfMonth returns the week number of the month in which t occurs.
+//
+// The week follows the given WeekRule. If it is omitted, WeekRuleUS is used.
+// Unlike WeekOfYear, days before the first week of the month are in week 0.
+// For example, with WeekRuleISO, 2023-09-01 (Friday) and 2023-09-03 (Sunday) are
+// in week 0 and 2023-09-04 (Monday) is in week 1.
+func (t Time[T]) WeekOfMonth(rule ...WeekRule) int {
+	r := weekRuleOf(rule)
+	year, month, day := t.Date()
+	first := time.Date(year, month, 1, 0, 0, 0, 0, time.UTC).Weekday()
+	return r.week(day, first)
+}
@@ -0,0 +1,125 @@
+package synchro_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/Code-Hex/synchro"
+	"github.com/Code-Hex/synchro/tz"
+)
+
+func TestTime_StartOfWeek_WeekRule(t *testing.T) {
+	// 2023-09-06 is Wednesday.
+	d := synchro.New[tz.UTC](2023, 9, 6, 15, 30, 0, 0)
+	tests := []struct {
+		rule      synchro.WeekRule
+		wantStart synchro.Time[tz.UTC]
+		wantEnd   synchro.Time[tz.UTC]
+	}{
+		{
+			rule:      synchro.WeekRuleUS,
+			wantStart: synchro.New[tz.UTC](2023, 9, 3, 15, 30, 0, 0),
+			wantEnd:   synchro.New[tz.UTC](2023, 9, 10, 15, 29, 59, 999999999),
+		},
+		{
+			rule:      synchro.WeekRuleISO,
+			wantStart: synchro.New[tz.UTC](2023, 9, 4, 15, 30, 0, 0),
+			wantEnd:   synchro.New[tz.UTC](2023, 9, 11, 15, 29, 59, 999999999),
+		},
+		{
+			rule:      synchro.WeekRuleMiddleEast,
+			wantStart: synchro.New[tz.UTC](2023, 9, 2, 15, 30, 0, 0),
+			wantEnd:   synchro.New[tz.UTC](2023, 9, 9, 15, 29, 59, 999999999),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.rule.FirstDay.String(), func(t *testing.T) {
+			if got := d.StartOfWeek(tt.rule); !tt.wantStart.Equal(got) {
+				t.Errorf("want start %s but got %s", tt.wantStart, got)
+			}
+			if got := d.EndOfWeek(tt.rule); !tt.wantEnd.Equal(got) {
+				t.Errorf("want end %s but got %s", tt.wantEnd, got)
+			}
+		})
+	}
+
+	// The time of day is kept across the daylight saving time change.
+	type NY = tz.AmericaNew_York
+	ny := synchro.New[NY](2023, 3, 15, 12, 0, 0, 0)
+	if want := synchro.New[NY](2023, 3, 12, 12, 0, 0, 0); !want.Equal(ny.StartOfWeek()) {
+		t.Errorf("want %s but got %s", want, ny.StartOfWeek())
+	}
+}
+
+func TestTime_WeekOfYear(t *testing.T) {
+	// WeekRuleISO agrees with ISOWeek.
+	for d := synchro.New[tz.UTC](2015, 12, 1, 0, 0, 0, 0); d.Year() < 2027; d = d.AddDate(0, 0, 1) {
+		wantYear, wantWeek := d.ISOWeek()
+		year, week := d.WeekOfYear(synchro.WeekRuleISO)
+		if year != wantYear || week != wantWeek {
+			t.Fatalf("%s: want %d-W%02d but got %d-W%02d", d, wantYear, wantWeek, year, week)
+		}
+	}
+
+	tests := []struct {
+		name     string
+		date     synchro.Time[tz.UTC]
+		rule     synchro.WeekRule
+		wantYear int
+		wantWeek int
+	}{
+		// 2022-01-01 is Saturday.
+		{name: "US first day", date: synchro.New[tz.UTC](2022, 1, 1, 0, 0, 0, 0), rule: synchro.WeekRuleUS, wantYear: 2022, wantWeek: 1},
+		{name: "US second week", date: synchro.New[tz.UTC](2022, 1, 2, 0, 0, 0, 0), rule: synchro.WeekRuleUS, wantYear: 2022, wantWeek: 2},
+		{name: "US last days", date: synchro.New[tz.UTC](2021, 12, 31, 0, 0, 0, 0), rule: synchro.WeekRuleUS, wantYear: 2022, wantWeek: 1},
+		{name: "US last week", date: synchro.New[tz.UTC](2022, 12, 31, 0, 0, 0, 0), rule: synchro.WeekRuleUS, wantYear: 2022, wantWeek: 53},
+		{name: "ISO last week of previous year", date: synchro.New[tz.UTC](2022, 1, 1, 0, 0, 0, 0), rule: synchro.WeekRuleISO, wantYear: 2021, wantWeek: 52},
+		{name: "Middle East first day", date: synchro.New[tz.UTC](2022, 1, 1, 0, 0, 0, 0), rule: synchro.WeekRuleMiddleEast, wantYear: 2022, wantWeek: 1},
+		{name: "Middle East last day", date: synchro.New[tz.UTC](2021, 12, 31, 0, 0, 0, 0), rule: synchro.WeekRuleMiddleEast, wantYear: 2021, wantWeek: 53},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			year, week := tt.date.WeekOfYear(tt.rule)
+			if year != tt.wantYear || week != tt.wantWeek {
+				t.Errorf("want %d week %d but got %d week %d", tt.wantYear, tt.wantWeek, year, week)
+			}
+		})
+	}
+}
+
+func TestTime_WeekOfMonth(t *testing.T) {
+	tests := []struct {
+		date synchro.Time[tz.UTC]
+		rule synchro.WeekRule
+		want int
+	}{
+		// 2023-09-01 is Friday.
+		{date: synchro.New[tz.UTC](2023, 9, 1, 0, 0, 0, 0), rule: synchro.WeekRuleUS, want: 1},
+		{date: synchro.New[tz.UTC](2023, 9, 3, 0, 0, 0, 0), rule: synchro.WeekRuleUS, want: 2},
+		{date: synchro.New[tz.UTC](2023, 9, 30, 0, 0, 0, 0), rule: synchro.WeekRuleUS, want: 5},
+		{date: synchro.New[tz.UTC](2023, 9, 1, 0, 0, 0, 0), rule: synchro.WeekRuleISO, want: 0},
+		{date: synchro.New[tz.UTC](2023, 9, 3, 0, 0, 0, 0), rule: synchro.WeekRuleISO, want: 0},
+		{date: synchro.New[tz.UTC](2023, 9, 4, 0, 0, 0, 0), rule: synchro.WeekRuleISO, want: 1},
+		{date: synchro.New[tz.UTC](2023, 9, 2, 0, 0, 0, 0), rule: synchro.WeekRuleMiddleEast, want: 2},
+		{date: synchro.New[tz.UTC](2023, 9, 1, 0, 0, 0, 0), rule: synchro.WeekRule{FirstDay: time.Monday, MinDays: 3}, want: 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.date.String(), func(t *testing.T) {
+			if got := tt.date.WeekOfMonth(tt.rule); got != tt.want {
+				t.Errorf("want %d but got %d", tt.want, got)
+			}
+		})
+	}
+}
+
+func ExampleTime_WeekOfYear() {
+	d := synchro.New[tz.UTC](2022, 1, 1, 0, 0, 0, 0)
+	fmt.Println(d.WeekOfYear(synchro.WeekRuleUS))
+	fmt.Println(d.WeekOfYear(synchro.WeekRuleISO))
+	fmt.Println(d.StartOfWeek(synchro.WeekRuleISO))
+	// Output:
+	// 2022 1
+	// 2021 52
+	// 2021-12-27 00:00:00 +0000 UTC
+}